@@ -3,6 +3,7 @@ module github.com/pda-labs/httpx
 go 1.24.3
 
 require (
+	github.com/gabriel-vasile/mimetype v1.4.8
 	github.com/go-chi/chi v1.5.5
 	github.com/go-playground/locales v0.14.1
 	github.com/go-playground/universal-translator v0.18.1
@@ -11,7 +12,6 @@ require (
 )
 
 require (
-	github.com/gabriel-vasile/mimetype v1.4.8 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
 	golang.org/x/crypto v0.33.0 // indirect
 	golang.org/x/net v0.34.0 // indirect
@@ -0,0 +1,128 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+// errBatchStatus - статус не соответствует исходу элемента (Add - 2xx, Fail - 4xx/5xx).
+var errBatchStatus = errors.New("httpx: batch item status")
+
+// BatchItem - результат одного элемента пакетной операции.
+type BatchItem struct {
+	Index  int         `json:"index"`
+	Status int         `json:"status"`
+	Data   any         `json:"data,omitempty"`
+	Error  *ErrorBlock `json:"error,omitempty"`
+}
+
+// BatchResult - тело ответа пакетной операции (Envelope.data или error.details).
+type BatchResult struct {
+	Items     []BatchItem `json:"items"`
+	Succeeded int         `json:"succeeded"`
+	Failed    int         `json:"failed"`
+}
+
+// Batch накапливает результаты элементов для MultiStatus.
+// Методы безопасны для вызова из нескольких горутин.
+//
+// Пример:
+//
+//	var b httpx.Batch
+//	for i, dto := range items {
+//	    user, err := svc.Create(ctx, dto)
+//	    switch {
+//	    case errors.Is(err, ErrDuplicate):
+//	        b.Fail(i, http.StatusConflict, "email already registered", nil)
+//	    case err != nil:
+//	        b.Fail(i, http.StatusInternalServerError, "internal error", nil)
+//	    default:
+//	        b.Add(i, http.StatusCreated, user)
+//	    }
+//	}
+//	httpx.MultiStatus(w, r, &b)
+type Batch struct {
+	mu    sync.Mutex
+	items []BatchItem
+}
+
+// Add записывает успешный результат элемента. status должен быть 2xx,
+// иначе элемент не записывается и возвращается ошибка.
+func (b *Batch) Add(index, status int, data any) error {
+	if status < http.StatusOK || status >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: Add with %d, want 2xx", errBatchStatus, status)
+	}
+	b.add(BatchItem{Index: index, Status: status, Data: data})
+	return nil
+}
+
+// Fail записывает ошибку элемента; code - как у хелпера Error* для status.
+// status должен быть 4xx/5xx, иначе элемент не записывается и возвращается ошибка.
+func (b *Batch) Fail(index, status int, message string, details any) error {
+	if status < http.StatusBadRequest || status > 599 {
+		return fmt.Errorf("%w: Fail with %d, want 4xx/5xx", errBatchStatus, status)
+	}
+	b.add(BatchItem{Index: index, Status: status, Error: &ErrorBlock{
+		Code:    ErrorCode(status),
+		Message: message,
+		Details: details,
+	}})
+	return nil
+}
+
+// Validation - ошибка валидации элемента, как ErrorValidation (400 VALIDATION).
+// details - например, ItemError.Details из BindStream.
+func (b *Batch) Validation(index int, details any) {
+	b.add(BatchItem{Index: index, Status: http.StatusBadRequest, Error: &ErrorBlock{
+		Code:    "VALIDATION",
+		Message: "Request failed validation",
+		Details: details,
+	}})
+}
+
+func (b *Batch) add(item BatchItem) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.items = append(b.items, item)
+}
+
+// result - элементы по порядку индексов + счётчики.
+func (b *Batch) result() BatchResult {
+	b.mu.Lock()
+	items := append([]BatchItem{}, b.items...)
+	b.mu.Unlock()
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Index < items[j].Index })
+
+	res := BatchResult{Items: items}
+	for _, it := range items {
+		if it.Error != nil {
+			res.Failed++
+		} else {
+			res.Succeeded++
+		}
+	}
+	return res
+}
+
+// status подбирает статус ответа: общий статус элементов, если он у всех
+// одинаковый; 200, если все успешны; иначе 207.
+func (res BatchResult) status() int {
+	if len(res.Items) == 0 {
+		return http.StatusOK
+	}
+
+	first := res.Items[0].Status
+	for _, it := range res.Items[1:] {
+		if it.Status != first {
+			if res.Failed == 0 {
+				return http.StatusOK
+			}
+			return http.StatusMultiStatus
+		}
+	}
+	return first
+}
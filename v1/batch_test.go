@@ -0,0 +1,101 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func serveBatch(t *testing.T, b *Batch) (int, Envelope) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	MultiStatus(rec, httptest.NewRequest(http.MethodPost, "/batch", nil), b)
+
+	var env Envelope
+	if err := json.Unmarshal(rec.Body.Bytes(), &env); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return rec.Code, env
+}
+
+func TestBatchRejectsMismatchedStatus(t *testing.T) {
+	var b Batch
+	if err := b.Add(0, http.StatusNotFound, nil); !errors.Is(err, errBatchStatus) {
+		t.Fatalf("Add(404) err = %v, want errBatchStatus", err)
+	}
+	if err := b.Fail(1, http.StatusOK, "x", nil); !errors.Is(err, errBatchStatus) {
+		t.Fatalf("Fail(200) err = %v, want errBatchStatus", err)
+	}
+	if err := b.Add(2, http.StatusCreated, "ok"); err != nil {
+		t.Fatalf("Add(201) err = %v", err)
+	}
+
+	status, env := serveBatch(t, &b)
+	if status != http.StatusCreated || !env.Success {
+		t.Fatalf("status = %d success = %v, want 201 true", status, env.Success)
+	}
+}
+
+func TestMultiStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		fill    func(b *Batch)
+		status  int
+		code    string
+		success bool
+	}{
+		{
+			name:    "mixed",
+			fill:    func(b *Batch) { b.Add(0, 201, 1); b.Fail(1, 409, "dup", nil) },
+			status:  http.StatusMultiStatus,
+			success: true,
+		},
+		{
+			name:    "all success different statuses",
+			fill:    func(b *Batch) { b.Add(0, 200, 1); b.Add(1, 201, 2) },
+			status:  http.StatusOK,
+			success: true,
+		},
+		{
+			name:   "all failed same status",
+			fill:   func(b *Batch) { b.Fail(0, 404, "gone", nil); b.Fail(1, 404, "gone", nil) },
+			status: http.StatusNotFound,
+			code:   "NOT_FOUND",
+		},
+		{
+			name:   "all failed mixed codes",
+			fill:   func(b *Batch) { b.Validation(0, nil); b.Fail(1, 400, "bad", nil) },
+			status: http.StatusBadRequest,
+			code:   "BAD_REQUEST",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b Batch
+			tt.fill(&b)
+			status, env := serveBatch(t, &b)
+			if status != tt.status || env.Success != tt.success {
+				t.Fatalf("status = %d success = %v, want %d %v", status, env.Success, tt.status, tt.success)
+			}
+			if tt.code != "" && (env.Error == nil || env.Error.Code != tt.code) {
+				t.Fatalf("error = %+v, want code %s", env.Error, tt.code)
+			}
+		})
+	}
+}
+
+func TestErrorCodeMatchesHelpers(t *testing.T) {
+	rec := httptest.NewRecorder()
+	ErrorConflict(rec, httptest.NewRequest(http.MethodGet, "/", nil), "x")
+
+	var env Envelope
+	_ = json.Unmarshal(rec.Body.Bytes(), &env)
+	if env.Error.Code != ErrorCode(http.StatusConflict) {
+		t.Fatalf("code = %s, want %s", env.Error.Code, ErrorCode(http.StatusConflict))
+	}
+	if got := ErrorCode(499); got != "ERROR" {
+		t.Fatalf("ErrorCode(499) = %s, want ERROR", got)
+	}
+}
@@ -1,10 +1,13 @@
 package httpx
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 )
@@ -17,11 +20,21 @@ var errValidatorUnset = errors.New("httpx: validator is not set (call httpx.V =
 
 const maxBodySize int64 = 1 << 23 // 8 MiB
 
+// errValidationAborted - контекст истёк или отменён во время валидации.
+var errValidationAborted = errors.New("httpx: validation aborted")
+
+// ValidationTimeout ограничивает время валидации в BindValidate
+// (актуально для правил из RegisterContextValidator). 0 - без лимита,
+// действует только отмена самого запроса.
+var ValidationTimeout time.Duration
+
 // BindValidate читает JSON‑тело, валидирует dst и локализует ошибки.
 //
 // Возвращает:
 //  1. details - map[field]translated msg; nil, если валидация прошла или ошибка другого типа.
 //  2. err     - любая ошибка процесса (декодинг, отсутствие валидатора, validator.ValidationErrors).
+//     Если контекст истёк во время валидации - ошибка оборачивает ctx.Err()
+//     (errors.Is(err, context.DeadlineExceeded)).
 //
 // Использование:
 //
@@ -52,32 +65,84 @@ func BindValidate[T any](r *http.Request, dst *T) (map[string]string, error) {
 				return nil, r.Context().Err()
 			default:
 			}
+			recordDecodeFailure(dst)
 			return nil, fmt.Errorf("%w: %v", errDecode, err)
 		}
 		// Проверяем trailing garbage
 		if decoder.More() {
+			recordDecodeFailure(dst)
 			return nil, fmt.Errorf("%w: extra data after JSON object", errDecode)
 		}
 	}
 
+	return validate(r, dst)
+}
+
+// validate - общий хвост биндинга: default → mod → V.StructCtx → перевод ошибок.
+// details != nil только для ошибок валидации.
+func validate(r *http.Request, dst any) (map[string]string, error) {
+	//  Значения по умолчанию: теги default:"20"
+	if err := applyDefaults(reflect.ValueOf(dst)); err != nil {
+		return nil, err
+	}
+
+	//  Нормализация: теги mod:"trim,lower"
+	if err := applyMods(reflect.ValueOf(dst)); err != nil {
+		return nil, err
+	}
+
 	//  Валидатор
 	if V == nil {
 		return nil, errValidatorUnset
 	}
 
-	//  Валидация
-	if err := V.Struct(dst); err != nil {
+	//  Валидация: контекст запроса доступен правилам из RegisterContextValidator
+	ctx := r.Context()
+	if ValidationTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ValidationTimeout)
+		defer cancel()
+	}
+
+	err := V.StructCtx(ctx, dst)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		// Правило могло вернуть false из-за отмены - результатам не верим
+		return nil, fmt.Errorf("%w: %w", errValidationAborted, ctxErr)
+	}
+	if err != nil {
 		var ve validator.ValidationErrors
 		if !errors.As(err, &ve) {
 			return nil, err
 		}
-		tr := TranslatorFor(r)
-		details := make(map[string]string, len(ve))
-		for _, fe := range ve {
-			details[fe.Field()] = fe.Translate(tr)
-		}
-		return details, err
+		recordBindFailures(dst, ve)
+		return translateErrors(r, reflect.TypeOf(dst), ve), err
 	}
 
 	return nil, nil
 }
+
+// translateErrors локализует ошибки валидации: map[field]translated msg.
+// typ - тип проверяемой структуры (для меток полей, см. RegisterFieldLabels).
+func translateErrors(r *http.Request, typ reflect.Type, ve validator.ValidationErrors) map[string]string {
+	tr := TranslatorFor(r)
+	details := make(map[string]string, len(ve))
+
+	var fallbacks []validator.FieldError
+	// Ленивая загрузка языка пишет в таблицы переводов V - читаем под RLock.
+	localesMu.RLock()
+	for _, fe := range ve {
+		msg := translateLabeled(tr, typ, fe)
+		if msg == fe.Error() { // перевода нет - validator отдал текст по умолчанию
+			fallbacks = append(fallbacks, fe)
+		}
+		details[fe.Field()] = msg
+	}
+	localesMu.RUnlock()
+
+	if hook := OnTranslationFallback; hook != nil {
+		for _, fe := range fallbacks {
+			hook(r, tr.Locale(), fe)
+		}
+	}
+	return details
+}
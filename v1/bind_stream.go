@@ -0,0 +1,152 @@
+package httpx
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"strconv"
+)
+
+const maxStreamSize int64 = 1 << 30 // 1 GiB
+
+// ItemError - элемент потока не прошёл валидацию.
+type ItemError struct {
+	Index   int               // номер элемента, с 0
+	Details map[string]string // map[field]translated msg
+	Err     error             // исходная validator.ValidationErrors
+}
+
+func (e *ItemError) Error() string {
+	return fmt.Sprintf("httpx: item %d: %v", e.Index, e.Err)
+}
+
+func (e *ItemError) Unwrap() error { return e.Err }
+
+// ItemErrors собирает ошибки элементов потока: index → details.
+// Подходит как details для ErrorValidation.
+type ItemErrors map[string]map[string]string
+
+// Add запоминает ошибку валидации элемента и возвращает true.
+// Для nil и прочих ошибок (декодинг, контекст) - false.
+func (e ItemErrors) Add(err error) bool {
+	var ie *ItemError
+	if !errors.As(err, &ie) {
+		return false
+	}
+	e[strconv.Itoa(ie.Index)] = ie.Details
+	return true
+}
+
+// BindStream читает из тела поток элементов - NDJSON или JSON-массив
+// верхнего уровня - и валидирует каждый, как BindValidate (default, mod, V),
+// не буферизуя тело целиком.
+//
+// На каждый элемент отдаёт (item, nil) или (item, *ItemError). Ошибка
+// декодинга или контекста отдаётся как (zero, err) и завершает поток.
+//
+// Пример:
+//
+//	errs := httpx.ItemErrors{}
+//	for row, err := range httpx.BindStream[RowDTO](r) {
+//	    if errs.Add(err) {
+//	        continue
+//	    }
+//	    if err != nil {
+//	        httpx.ErrorBadRequest(w, r, err.Error())
+//	        return
+//	    }
+//	    batch.Add(row)
+//	}
+//	if len(errs) > 0 {
+//	    httpx.ErrorValidation(w, r, errs) // {"3": {"Email": "..."}}
+//	    return
+//	}
+func BindStream[T any](r *http.Request) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		defer r.Body.Close()
+
+		br := bufio.NewReader(http.MaxBytesReader(nil, r.Body, maxStreamSize))
+		decoder := json.NewDecoder(br)
+		decoder.DisallowUnknownFields()
+
+		fail := func(err error) {
+			select { // если ctx отменён - лучше вернуть context error
+			case <-r.Context().Done():
+				err = r.Context().Err()
+			default:
+				recordDecodeFailure(&zero)
+				err = fmt.Errorf("%w: %v", errDecode, err)
+			}
+			yield(zero, err)
+		}
+
+		array, err := isJSONArray(br)
+		if err != nil {
+			if err != io.EOF {
+				fail(err)
+			}
+			return // пустое тело - пустой поток
+		}
+		if array {
+			if _, err := decoder.Token(); err != nil { // '['
+				fail(err)
+				return
+			}
+		}
+
+		for i := 0; ; i++ {
+			if array && !decoder.More() {
+				if _, err := decoder.Token(); err != nil { // ']'
+					fail(err)
+					return
+				}
+				if decoder.More() {
+					fail(errors.New("extra data after JSON array"))
+				}
+				return
+			}
+
+			var item T
+			if err := decoder.Decode(&item); err != nil {
+				if err == io.EOF && !array {
+					return
+				}
+				fail(err)
+				return
+			}
+
+			details, err := validate(r, &item)
+			if err != nil {
+				if details == nil {
+					yield(zero, err) // валидатор не настроен, контекст истёк и т.п.
+					return
+				}
+				err = &ItemError{Index: i, Details: details, Err: err}
+			}
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
+
+// isJSONArray смотрит первый значимый байт тела, не вычитывая его.
+func isJSONArray(br *bufio.Reader) (bool, error) {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return false, err
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = br.ReadByte()
+		default:
+			return b[0] == '[', nil
+		}
+	}
+}
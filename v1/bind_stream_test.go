@@ -0,0 +1,93 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type rowDTO struct {
+	Name string `json:"name" validate:"required"`
+	Qty  int    `json:"qty" validate:"gte=1"`
+}
+
+// collectStream читает весь BindStream[rowDTO] из body.
+func collectStream(body string) (rows []rowDTO, itemErrs ItemErrors, err error) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	itemErrs = ItemErrors{}
+	for row, e := range BindStream[rowDTO](r) {
+		if itemErrs.Add(e) {
+			continue
+		}
+		if e != nil {
+			return rows, itemErrs, e
+		}
+		rows = append(rows, row)
+	}
+	return rows, itemErrs, nil
+}
+
+func TestBindStream(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		rows    int
+		invalid []string
+	}{
+		{"ndjson", "{\"name\":\"a\",\"qty\":1}\n{\"name\":\"b\",\"qty\":2}\n", 2, nil},
+		{"array", ` [{"name":"a","qty":1},{"name":"b","qty":2}] `, 2, nil},
+		{"empty body", "", 0, nil},
+		{"empty array", "[]", 0, nil},
+		{"invalid items", `[{"name":"a","qty":1},{"qty":1},{"name":"c","qty":0}]`, 1, []string{"1", "2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, itemErrs, err := collectStream(tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rows) != tt.rows {
+				t.Errorf("rows = %+v, want %d", rows, tt.rows)
+			}
+			if len(itemErrs) != len(tt.invalid) {
+				t.Fatalf("item errors = %v, want indexes %v", itemErrs, tt.invalid)
+			}
+			for _, idx := range tt.invalid {
+				if len(itemErrs[idx]) == 0 {
+					t.Errorf("no details for item %s: %v", idx, itemErrs)
+				}
+			}
+		})
+	}
+}
+
+func TestBindStreamDecodeErrors(t *testing.T) {
+	for name, body := range map[string]string{
+		"broken item":    `[{"name":"a","qty":1},{"name":`,
+		"unknown field":  `{"name":"a","qty":1,"extra":true}`,
+		"trailing data":  `[{"name":"a","qty":1}] {}`,
+		"not an object":  `"text"`,
+		"unclosed array": `[{"name":"a","qty":1}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := collectStream(body)
+			if !errors.Is(err, errDecode) {
+				t.Errorf("err = %v, want errDecode", err)
+			}
+		})
+	}
+}
+
+func TestBindStreamStopsEarly(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"a","qty":1}`+"\n"+`{"name":"b","qty":1}`))
+	n := 0
+	for range BindStream[rowDTO](r) {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("items = %d, want 1", n)
+	}
+}
@@ -0,0 +1,58 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type ctxKey struct{}
+
+type signupDTO struct {
+	Email string `json:"email" validate:"required,test_unique_email"`
+}
+
+func postJSON(ctx context.Context, body string) *http.Request {
+	r := httptest.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Accept-Language", "en")
+	return r
+}
+
+func TestContextValidator(t *testing.T) {
+	err := RegisterContextValidator("test_unique_email", func(ctx context.Context, fl validator.FieldLevel) bool {
+		if slow, _ := ctx.Value(ctxKey{}).(bool); slow {
+			<-ctx.Done()
+			return false
+		}
+		return fl.Field().String() != "taken@example.com"
+	}, map[string]string{"en": "{0} is already registered"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dto signupDTO
+	if details, err := BindValidate(postJSON(context.Background(), `{"email":"new@example.com"}`), &dto); err != nil {
+		t.Fatalf("details = %v, err = %v", details, err)
+	}
+
+	details, err := BindValidate(postJSON(context.Background(), `{"email":"taken@example.com"}`), &dto)
+	if err == nil || details["Email"] != "Email is already registered" {
+		t.Fatalf("details = %v, err = %v", details, err)
+	}
+
+	prev := ValidationTimeout
+	ValidationTimeout = 10 * time.Millisecond
+	t.Cleanup(func() { ValidationTimeout = prev })
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, true)
+	details, err = BindValidate(postJSON(ctx, `{"email":"new@example.com"}`), &dto)
+	if details != nil || !errors.Is(err, errValidationAborted) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("timeout: details = %v, err = %v", details, err)
+	}
+}
@@ -0,0 +1,163 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CachePolicy - декларативная политика кеширования ответа: Cache-Control,
+// Expires, Vary и Surrogate-Key (ключи инвалидации CDN).
+//
+// Пример:
+//
+//	var articleCache = httpx.CachePolicy{
+//	    Public:               true,
+//	    MaxAge:               time.Minute,
+//	    SMaxAge:              10 * time.Minute,
+//	    StaleWhileRevalidate: 30 * time.Second,
+//	    Vary:                 []string{"Accept-Language"},
+//	}
+type CachePolicy struct {
+	Public         bool // кешируют и общие кеши (CDN, прокси)
+	Private        bool // только браузер пользователя
+	NoStore        bool // не сохранять вовсе; остальные поля игнорируются
+	NoCache        bool // хранить, но перепроверять перед каждым использованием
+	MustRevalidate bool
+	Immutable      bool
+
+	MaxAge               time.Duration
+	SMaxAge              time.Duration // для общих кешей
+	StaleWhileRevalidate time.Duration
+	StaleIfError         time.Duration
+
+	Vary          []string
+	SurrogateKeys []string
+
+	// Negative - политика для 404/410 на этом роуте; nil - NegativeCache.
+	Negative *CachePolicy
+}
+
+// NoStore - политика «не кешировать», её же получают ответы с ошибками.
+var NoStore = CachePolicy{NoStore: true}
+
+// NegativeCache - политика для 404 и 410 по умолчанию. Нулевое значение -
+// no-store, как у остальных ошибок. Например, чтобы CDN минуту помнил
+// отсутствие ресурса:
+//
+//	httpx.NegativeCache = httpx.CachePolicy{Public: true, SMaxAge: time.Minute}
+var NegativeCache CachePolicy
+
+type cacheCtxKey struct{}
+
+// Apply выставляет заголовки политики в w (до записи статуса).
+func (p CachePolicy) Apply(w http.ResponseWriter) {
+	h := w.Header()
+	h.Set("Cache-Control", p.String())
+
+	if p.MaxAge > 0 && !p.NoStore && !p.NoCache {
+		h.Set("Expires", time.Now().Add(p.MaxAge).UTC().Format(http.TimeFormat))
+	} else {
+		h.Del("Expires")
+	}
+
+	if !p.NoStore && len(p.SurrogateKeys) > 0 {
+		h.Set("Surrogate-Key", strings.Join(p.SurrogateKeys, " "))
+	} else {
+		h.Del("Surrogate-Key")
+	}
+
+	for _, v := range p.Vary {
+		addVary(h, v)
+	}
+}
+
+// String - значение Cache-Control.
+func (p CachePolicy) String() string {
+	if p.NoStore {
+		return "no-store"
+	}
+
+	var d []string
+	switch {
+	case p.Public:
+		d = append(d, "public")
+	case p.Private:
+		d = append(d, "private")
+	}
+	if p.NoCache {
+		d = append(d, "no-cache")
+	}
+	if p.MaxAge > 0 || p.Public || p.Private {
+		d = append(d, "max-age="+seconds(p.MaxAge))
+	}
+	if p.SMaxAge > 0 {
+		d = append(d, "s-maxage="+seconds(p.SMaxAge))
+	}
+	if p.StaleWhileRevalidate > 0 {
+		d = append(d, "stale-while-revalidate="+seconds(p.StaleWhileRevalidate))
+	}
+	if p.StaleIfError > 0 {
+		d = append(d, "stale-if-error="+seconds(p.StaleIfError))
+	}
+	if p.MustRevalidate {
+		d = append(d, "must-revalidate")
+	}
+	if p.Immutable {
+		d = append(d, "immutable")
+	}
+	if len(d) == 0 {
+		return "no-cache"
+	}
+	return strings.Join(d, ", ")
+}
+
+// Cache - middleware: политика p для всех ответов роута. Успешные ответы
+// получают её заголовки, ошибки - no-store (404/410 - p.Negative или
+// NegativeCache). Хендлер может перекрыть политику через Apply.
+//
+// Пример:
+//
+//	r.With(httpx.Cache(articleCache)).Get("/articles/{id}", getArticle)
+func Cache(p CachePolicy) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p.Apply(w)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), cacheCtxKey{}, p)))
+		})
+	}
+}
+
+// applyErrorCache - политика для ответа с ошибкой (вызывается из Error).
+func applyErrorCache(w http.ResponseWriter, r *http.Request, status int) {
+	if status == http.StatusNotFound || status == http.StatusGone {
+		neg := NegativeCache
+		if p, ok := r.Context().Value(cacheCtxKey{}).(CachePolicy); ok && p.Negative != nil {
+			neg = *p.Negative
+		}
+		if !reflect.ValueOf(neg).IsZero() {
+			neg.Apply(w)
+			return
+		}
+	}
+	NoStore.Apply(w)
+}
+
+// addVary добавляет поле в Vary, если его там ещё нет.
+func addVary(h http.Header, field string) {
+	for _, v := range h.Values("Vary") {
+		for _, f := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(f), field) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", field)
+}
+
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10)
+}
@@ -0,0 +1,97 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCachePolicyString(t *testing.T) {
+	tests := []struct {
+		p    CachePolicy
+		want string
+	}{
+		{CachePolicy{}, "no-cache"},
+		{NoStore, "no-store"},
+		{CachePolicy{NoStore: true, Public: true, MaxAge: time.Hour}, "no-store"},
+		{CachePolicy{Public: true}, "public, max-age=0"},
+		{CachePolicy{Private: true, MaxAge: time.Minute}, "private, max-age=60"},
+		{CachePolicy{NoCache: true}, "no-cache"},
+		{
+			CachePolicy{Public: true, MaxAge: time.Minute, SMaxAge: 10 * time.Minute, StaleWhileRevalidate: 30 * time.Second, StaleIfError: time.Hour},
+			"public, max-age=60, s-maxage=600, stale-while-revalidate=30, stale-if-error=3600",
+		},
+		{CachePolicy{MaxAge: 365 * 24 * time.Hour, Immutable: true, MustRevalidate: true}, "max-age=31536000, must-revalidate, immutable"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("%+v: %q, want %q", tt.p, got, tt.want)
+		}
+	}
+}
+
+func TestCachePolicyApply(t *testing.T) {
+	rec := httptest.NewRecorder()
+	rec.Header().Set("Vary", "Accept-Encoding")
+	CachePolicy{
+		Public:        true,
+		MaxAge:        time.Minute,
+		Vary:          []string{"accept-encoding", "Accept-Language"},
+		SurrogateKeys: []string{"article-1", "articles"},
+	}.Apply(rec)
+
+	h := rec.Header()
+	if exp, err := http.ParseTime(h.Get("Expires")); err != nil || time.Until(exp) > time.Minute+time.Second {
+		t.Errorf("Expires = %q", h.Get("Expires"))
+	}
+	if got := h.Get("Surrogate-Key"); got != "article-1 articles" {
+		t.Errorf("Surrogate-Key = %q", got)
+	}
+	if got := h.Values("Vary"); len(got) != 2 || got[1] != "Accept-Language" {
+		t.Errorf("Vary = %q", got)
+	}
+
+	NoStore.Apply(rec) // no-store снимает Expires и Surrogate-Key
+	if h.Get("Expires") != "" || h.Get("Surrogate-Key") != "" || h.Get("Cache-Control") != "no-store" {
+		t.Errorf("headers after NoStore = %v", h)
+	}
+}
+
+func TestCacheMiddleware(t *testing.T) {
+	policy := CachePolicy{Public: true, MaxAge: time.Minute}
+	negative := CachePolicy{Public: true, SMaxAge: 30 * time.Second}
+
+	tests := []struct {
+		name     string
+		policy   CachePolicy
+		negative CachePolicy
+		handler  http.HandlerFunc
+		want     string
+	}{
+		{"success", policy, CachePolicy{}, func(w http.ResponseWriter, r *http.Request) { JSON(w, r, http.StatusOK, 1) }, "public, max-age=60"},
+		{"error", policy, CachePolicy{}, func(w http.ResponseWriter, r *http.Request) { ErrorConflict(w, r, "x") }, "no-store"},
+		{"not found", policy, CachePolicy{}, func(w http.ResponseWriter, r *http.Request) { ErrorNotFound(w, r, "x") }, "no-store"},
+		{"not found, NegativeCache", policy, negative, func(w http.ResponseWriter, r *http.Request) { ErrorNotFound(w, r, "x") }, "public, max-age=0, s-maxage=30"},
+		{
+			"not found, route policy", CachePolicy{Public: true, Negative: &CachePolicy{Private: true, MaxAge: 5 * time.Second}}, negative,
+			func(w http.ResponseWriter, r *http.Request) { ErrorNotFound(w, r, "x") }, "private, max-age=5",
+		},
+		{"handler override", policy, CachePolicy{}, func(w http.ResponseWriter, r *http.Request) {
+			CachePolicy{Private: true}.Apply(w)
+			JSON(w, r, http.StatusOK, 1)
+		}, "private, max-age=0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := NegativeCache
+			NegativeCache = tt.negative
+			t.Cleanup(func() { NegativeCache = prev })
+
+			rec := get(Cache(tt.policy)(tt.handler), "/")
+			if got := rec.Header().Get("Cache-Control"); got != tt.want {
+				t.Errorf("Cache-Control = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,7 +16,7 @@ import "net/http"
 //
 // Code:   BAD_REQUEST
 func ErrorBadRequest(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusBadRequest, "BAD_REQUEST", msg, nil)
+	Error(w, r, http.StatusBadRequest, ErrorCode(http.StatusBadRequest), msg, nil)
 }
 
 // ErrorValidation - 400 VALIDATION
@@ -51,7 +51,7 @@ func ErrorValidation(w http.ResponseWriter, r *http.Request, details interface{}
 //
 // Code:   UNAUTHORIZED
 func ErrorUnauthorized(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusUnauthorized, "UNAUTHORIZED", msg, nil)
+	Error(w, r, http.StatusUnauthorized, ErrorCode(http.StatusUnauthorized), msg, nil)
 }
 
 /* 402 */
@@ -68,7 +68,7 @@ func ErrorUnauthorized(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   PAYMENT_REQUIRED
 func ErrorPaymentRequired(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusPaymentRequired, "PAYMENT_REQUIRED", msg, nil)
+	Error(w, r, http.StatusPaymentRequired, ErrorCode(http.StatusPaymentRequired), msg, nil)
 }
 
 /* 403 */
@@ -86,7 +86,7 @@ func ErrorPaymentRequired(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   FORBIDDEN
 func ErrorForbidden(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusForbidden, "FORBIDDEN", msg, nil)
+	Error(w, r, http.StatusForbidden, ErrorCode(http.StatusForbidden), msg, nil)
 }
 
 /* 404 */
@@ -106,7 +106,7 @@ func ErrorNotFound(w http.ResponseWriter, r *http.Request, res string) {
 	if res != "" {
 		txt = res + " not found"
 	}
-	Error(w, r, http.StatusNotFound, "NOT_FOUND", txt, nil)
+	Error(w, r, http.StatusNotFound, ErrorCode(http.StatusNotFound), txt, nil)
 }
 
 /* 405 */
@@ -120,7 +120,7 @@ func ErrorNotFound(w http.ResponseWriter, r *http.Request, res string) {
 //
 // Code:   METHOD_NOT_ALLOWED
 func ErrorMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
-	Error(w, r, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "Method not allowed", nil)
+	Error(w, r, http.StatusMethodNotAllowed, ErrorCode(http.StatusMethodNotAllowed), "Method not allowed", nil)
 }
 
 /* 406 */
@@ -134,7 +134,7 @@ func ErrorMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
 //
 // Code:   NOT_ACCEPTABLE
 func ErrorNotAcceptable(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusNotAcceptable, "NOT_ACCEPTABLE", msg, nil)
+	Error(w, r, http.StatusNotAcceptable, ErrorCode(http.StatusNotAcceptable), msg, nil)
 }
 
 /* 407 */
@@ -149,7 +149,7 @@ func ErrorNotAcceptable(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   PROXY_AUTH_REQUIRED
 func ErrorProxyAuthRequired(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusProxyAuthRequired, "PROXY_AUTH_REQUIRED", msg, nil)
+	Error(w, r, http.StatusProxyAuthRequired, ErrorCode(http.StatusProxyAuthRequired), msg, nil)
 }
 
 /* 408 */
@@ -164,7 +164,7 @@ func ErrorProxyAuthRequired(w http.ResponseWriter, r *http.Request, msg string)
 //
 // Code:   REQUEST_TIMEOUT
 func ErrorRequestTimeout(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusRequestTimeout, "REQUEST_TIMEOUT", msg, nil)
+	Error(w, r, http.StatusRequestTimeout, ErrorCode(http.StatusRequestTimeout), msg, nil)
 }
 
 /* 409 */
@@ -180,7 +180,7 @@ func ErrorRequestTimeout(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   CONFLICT
 func ErrorConflict(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusConflict, "CONFLICT", msg, nil)
+	Error(w, r, http.StatusConflict, ErrorCode(http.StatusConflict), msg, nil)
 }
 
 /* 410 */
@@ -198,7 +198,7 @@ func ErrorGone(w http.ResponseWriter, r *http.Request, res string) {
 	if res != "" {
 		txt = res + " is gone"
 	}
-	Error(w, r, http.StatusGone, "GONE", txt, nil)
+	Error(w, r, http.StatusGone, ErrorCode(http.StatusGone), txt, nil)
 }
 
 /* 411 */
@@ -212,7 +212,7 @@ func ErrorGone(w http.ResponseWriter, r *http.Request, res string) {
 //
 // Code:   LENGTH_REQUIRED
 func ErrorLengthRequired(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusLengthRequired, "LENGTH_REQUIRED", msg, nil)
+	Error(w, r, http.StatusLengthRequired, ErrorCode(http.StatusLengthRequired), msg, nil)
 }
 
 /* 412 */
@@ -226,7 +226,7 @@ func ErrorLengthRequired(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   PRECONDITION_FAILED
 func ErrorPreconditionFailed(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusPreconditionFailed, "PRECONDITION_FAILED", msg, nil)
+	Error(w, r, http.StatusPreconditionFailed, ErrorCode(http.StatusPreconditionFailed), msg, nil)
 }
 
 /* 413 */
@@ -240,7 +240,7 @@ func ErrorPreconditionFailed(w http.ResponseWriter, r *http.Request, msg string)
 //
 // Code:   PAYLOAD_TOO_LARGE
 func ErrorPayloadTooLarge(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", msg, nil)
+	Error(w, r, http.StatusRequestEntityTooLarge, ErrorCode(http.StatusRequestEntityTooLarge), msg, nil)
 }
 
 /* 414 */
@@ -254,7 +254,7 @@ func ErrorPayloadTooLarge(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   URI_TOO_LONG
 func ErrorURITooLong(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusRequestURITooLong, "URI_TOO_LONG", msg, nil)
+	Error(w, r, http.StatusRequestURITooLong, ErrorCode(http.StatusRequestURITooLong), msg, nil)
 }
 
 /* 415 */
@@ -268,7 +268,7 @@ func ErrorURITooLong(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   UNSUPPORTED_MEDIA_TYPE
 func ErrorUnsupportedMediaType(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", msg, nil)
+	Error(w, r, http.StatusUnsupportedMediaType, ErrorCode(http.StatusUnsupportedMediaType), msg, nil)
 }
 
 /* 416 */
@@ -281,7 +281,7 @@ func ErrorUnsupportedMediaType(w http.ResponseWriter, r *http.Request, msg strin
 //
 // Code:   RANGE_NOT_SATISFIABLE
 func ErrorRangeNotSatisfiable(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusRequestedRangeNotSatisfiable, "RANGE_NOT_SATISFIABLE", msg, nil)
+	Error(w, r, http.StatusRequestedRangeNotSatisfiable, ErrorCode(http.StatusRequestedRangeNotSatisfiable), msg, nil)
 }
 
 /* 417 */
@@ -294,7 +294,7 @@ func ErrorRangeNotSatisfiable(w http.ResponseWriter, r *http.Request, msg string
 //
 // Code:   EXPECTATION_FAILED
 func ErrorExpectationFailed(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusExpectationFailed, "EXPECTATION_FAILED", msg, nil)
+	Error(w, r, http.StatusExpectationFailed, ErrorCode(http.StatusExpectationFailed), msg, nil)
 }
 
 /* 418 */
@@ -308,7 +308,7 @@ func ErrorExpectationFailed(w http.ResponseWriter, r *http.Request, msg string)
 //
 // Code:   TEAPOT
 func ErrorTeapot(w http.ResponseWriter, r *http.Request) {
-	Error(w, r, http.StatusTeapot, "TEAPOT", "I'm a teapot", nil)
+	Error(w, r, http.StatusTeapot, ErrorCode(http.StatusTeapot), "I'm a teapot", nil)
 }
 
 /* 421 */
@@ -322,7 +322,7 @@ func ErrorTeapot(w http.ResponseWriter, r *http.Request) {
 //
 // Code:   MISDIRECTED_REQUEST
 func ErrorMisdirectedRequest(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusMisdirectedRequest, "MISDIRECTED_REQUEST", msg, nil)
+	Error(w, r, http.StatusMisdirectedRequest, ErrorCode(http.StatusMisdirectedRequest), msg, nil)
 }
 
 /* 422 */
@@ -336,7 +336,7 @@ func ErrorMisdirectedRequest(w http.ResponseWriter, r *http.Request, msg string)
 //
 // Code:   UNPROCESSABLE
 func ErrorUnprocessableEntity(w http.ResponseWriter, r *http.Request, msg string, det interface{}) {
-	Error(w, r, http.StatusUnprocessableEntity, "UNPROCESSABLE", msg, det)
+	Error(w, r, http.StatusUnprocessableEntity, ErrorCode(http.StatusUnprocessableEntity), msg, det)
 }
 
 /* 423 */
@@ -350,7 +350,7 @@ func ErrorUnprocessableEntity(w http.ResponseWriter, r *http.Request, msg string
 //
 // Code:   LOCKED
 func ErrorLocked(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusLocked, "LOCKED", msg, nil)
+	Error(w, r, http.StatusLocked, ErrorCode(http.StatusLocked), msg, nil)
 }
 
 /* 424 */
@@ -363,7 +363,7 @@ func ErrorLocked(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   FAILED_DEPENDENCY
 func ErrorFailedDependency(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusFailedDependency, "FAILED_DEPENDENCY", msg, nil)
+	Error(w, r, http.StatusFailedDependency, ErrorCode(http.StatusFailedDependency), msg, nil)
 }
 
 /* 425 */
@@ -377,7 +377,7 @@ func ErrorFailedDependency(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   TOO_EARLY
 func ErrorTooEarly(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusTooEarly, "TOO_EARLY", msg, nil)
+	Error(w, r, http.StatusTooEarly, ErrorCode(http.StatusTooEarly), msg, nil)
 }
 
 /* 426 */
@@ -390,7 +390,7 @@ func ErrorTooEarly(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   UPGRADE_REQUIRED
 func ErrorUpgradeRequired(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusUpgradeRequired, "UPGRADE_REQUIRED", msg, nil)
+	Error(w, r, http.StatusUpgradeRequired, ErrorCode(http.StatusUpgradeRequired), msg, nil)
 }
 
 /* 428 */
@@ -404,7 +404,7 @@ func ErrorUpgradeRequired(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   PRECONDITION_REQUIRED
 func ErrorPreconditionRequired(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusPreconditionRequired, "PRECONDITION_REQUIRED", msg, nil)
+	Error(w, r, http.StatusPreconditionRequired, ErrorCode(http.StatusPreconditionRequired), msg, nil)
 }
 
 /* 429 */
@@ -418,7 +418,7 @@ func ErrorPreconditionRequired(w http.ResponseWriter, r *http.Request, msg strin
 //
 // Code:   RATE_LIMIT
 func ErrorTooManyRequests(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusTooManyRequests, "RATE_LIMIT", msg, nil)
+	Error(w, r, http.StatusTooManyRequests, ErrorCode(http.StatusTooManyRequests), msg, nil)
 }
 
 /* 431 */
@@ -431,7 +431,7 @@ func ErrorTooManyRequests(w http.ResponseWriter, r *http.Request, msg string) {
 //
 // Code:   HEADER_FIELDS_TOO_LARGE
 func ErrorHeaderFieldsTooLarge(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusRequestHeaderFieldsTooLarge, "HEADER_FIELDS_TOO_LARGE", msg, nil)
+	Error(w, r, http.StatusRequestHeaderFieldsTooLarge, ErrorCode(http.StatusRequestHeaderFieldsTooLarge), msg, nil)
 }
 
 /* 451 */
@@ -445,5 +445,5 @@ func ErrorHeaderFieldsTooLarge(w http.ResponseWriter, r *http.Request, msg strin
 //
 // Code:   LEGAL_REASONS
 func ErrorLegalReasons(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusUnavailableForLegalReasons, "LEGAL_REASONS", msg, nil)
+	Error(w, r, http.StatusUnavailableForLegalReasons, ErrorCode(http.StatusUnavailableForLegalReasons), msg, nil)
 }
@@ -0,0 +1,259 @@
+package httpx
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CompressMinSize - тела меньше стольких байт отдаются без сжатия:
+// заголовки и словарь gzip съедят весь выигрыш.
+var CompressMinSize = 1024
+
+// Encoder - потоковый компрессор. Подходят *gzip.Writer, *brotli.Writer
+// (github.com/andybalholm/brotli) и *zstd.Encoder (github.com/klauspost/compress/zstd).
+type Encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+var (
+	encodersMu    sync.RWMutex
+	encoders      = map[string]*sync.Pool{}
+	encodingOrder []string // предпочтение сервера при равных q клиента
+)
+
+func init() {
+	RegisterEncoder("gzip", func() Encoder { return gzip.NewWriter(io.Discard) })
+}
+
+// RegisterEncoder добавляет (или заменяет) кодировку для Compress.
+// newEncoder создаёт компрессор, который затем переиспользуется через
+// sync.Pool (Reset перед каждым ответом). Позже зарегистрированные
+// кодировки предпочтительнее при равном q в Accept-Encoding.
+//
+// Пример:
+//
+//	httpx.RegisterEncoder("br", func() httpx.Encoder {
+//	    return brotli.NewWriterLevel(nil, brotli.DefaultCompression)
+//	})
+//	httpx.RegisterEncoder("zstd", func() httpx.Encoder {
+//	    enc, _ := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+//	    return enc
+//	})
+func RegisterEncoder(name string, newEncoder func() Encoder) {
+	name = strings.ToLower(name)
+
+	encodersMu.Lock()
+	defer encodersMu.Unlock()
+	if _, ok := encoders[name]; !ok {
+		encodingOrder = append([]string{name}, encodingOrder...)
+	}
+	encoders[name] = &sync.Pool{New: func() any { return newEncoder() }}
+}
+
+// Compress - middleware: сжимает ответ кодировкой из Accept-Encoding
+// (gzip и всё, что добавлено через RegisterEncoder) и ставит
+// Vary: Accept-Encoding.
+//
+// Без сжатия уходят: тела меньше CompressMinSize, уже сжатые типы
+// (изображения, видео, архивы), 204/304 (NoContent, RedirectNotModified),
+// 206 (ServeRange), HEAD, ответы с Content-Encoding или
+// Cache-Control: no-transform. Сильный ETag при сжатии становится слабым.
+//
+// Ставьте снаружи ETag и ResponseCache - они работают с несжатым телом.
+//
+// Пример:
+//
+//	r.Use(httpx.Compress)
+func Compress(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addVary(w.Header(), "Accept-Encoding")
+
+		name, pool := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if pool == nil || r.Method == http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w, encoding: name, pool: pool, status: http.StatusOK}
+		next.ServeHTTP(cw, r)
+		cw.close()
+	})
+}
+
+// negotiateEncoding выбирает кодировку с наибольшим q; nil - без сжатия.
+func negotiateEncoding(accept string) (string, *sync.Pool) {
+	if accept == "" {
+		return "", nil
+	}
+
+	q := map[string]float64{}
+	for _, part := range strings.Split(accept, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				weight = f
+			}
+		}
+		q[strings.ToLower(strings.TrimSpace(name))] = weight
+	}
+
+	encodersMu.RLock()
+	defer encodersMu.RUnlock()
+
+	best, bestQ := "", 0.0
+	for _, name := range encodingOrder {
+		w, ok := q[name]
+		if !ok {
+			w = q["*"]
+		}
+		if w > bestQ {
+			best, bestQ = name, w
+		}
+	}
+	if best == "" {
+		return "", nil
+	}
+	return best, encoders[best]
+}
+
+// compressWriter копит начало тела до CompressMinSize, затем решает,
+// сжимать ли ответ, и дальше пишет напрямую (через enc или без него).
+type compressWriter struct {
+	http.ResponseWriter
+	encoding string
+	pool     *sync.Pool
+
+	status      int
+	wroteHeader bool // хендлер вызвал WriteHeader
+	decided     bool
+	buf         []byte
+	enc         Encoder
+}
+
+func (cw *compressWriter) WriteHeader(status int) {
+	if cw.decided || cw.wroteHeader {
+		if cw.decided {
+			cw.ResponseWriter.WriteHeader(status)
+		}
+		return
+	}
+	if status < http.StatusOK { // 1xx - информационные, ответ ещё впереди
+		cw.ResponseWriter.WriteHeader(status)
+		return
+	}
+	cw.status = status
+	cw.wroteHeader = true
+	if !bodyAllowed(status) || status == http.StatusPartialContent {
+		cw.decide(false)
+	}
+}
+
+func (cw *compressWriter) Write(b []byte) (int, error) {
+	if !cw.decided {
+		cw.buf = append(cw.buf, b...)
+		if len(cw.buf) >= CompressMinSize {
+			cw.decide(true)
+		}
+		return len(b), nil
+	}
+	if cw.enc != nil {
+		return cw.enc.Write(b)
+	}
+	return cw.ResponseWriter.Write(b)
+}
+
+// Flush - потоковый ответ (SSE, NDJSON): решаем без ожидания CompressMinSize.
+func (cw *compressWriter) Flush() {
+	if !cw.decided {
+		cw.decide(true)
+	}
+	if cw.enc != nil {
+		_ = cw.enc.Flush()
+	}
+	_ = http.NewResponseController(cw.ResponseWriter).Flush()
+}
+
+func (cw *compressWriter) Unwrap() http.ResponseWriter {
+	return cw.ResponseWriter
+}
+
+// decide отправляет заголовки и накопленное тело; compress - размер
+// позволяет сжатие, остальные условия проверяются здесь.
+func (cw *compressWriter) decide(compress bool) {
+	cw.decided = true
+	h := cw.Header()
+
+	if compress && len(cw.buf) > 0 && h.Get("Content-Type") == "" {
+		h.Set("Content-Type", http.DetectContentType(cw.buf))
+	}
+	compress = compress && h.Get("Content-Encoding") == "" &&
+		!strings.Contains(strings.ToLower(h.Get("Cache-Control")), "no-transform") &&
+		compressible(h.Get("Content-Type"))
+
+	if compress {
+		h.Set("Content-Encoding", cw.encoding)
+		h.Del("Content-Length")
+		h.Del("Accept-Ranges")
+		if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			h.Set("ETag", "W/"+etag)
+		}
+		cw.enc = cw.pool.Get().(Encoder)
+		cw.enc.Reset(cw.ResponseWriter)
+	}
+
+	cw.ResponseWriter.WriteHeader(cw.status)
+	if len(cw.buf) > 0 {
+		_, _ = cw.Write(cw.buf)
+		cw.buf = nil
+	}
+}
+
+// close дописывает маленькое тело без сжатия или закрывает компрессор.
+func (cw *compressWriter) close() {
+	if !cw.decided {
+		if !cw.wroteHeader && len(cw.buf) == 0 {
+			return // хендлер ничего не написал - net/http ответит 200 сам
+		}
+		cw.decide(false)
+	}
+	if cw.enc != nil {
+		_ = cw.enc.Close()
+		cw.enc.Reset(io.Discard)
+		cw.pool.Put(cw.enc)
+		cw.enc = nil
+	}
+}
+
+// bodyAllowed - у статуса может быть тело (RFC 9110 §6.4.1).
+func bodyAllowed(status int) bool {
+	return status >= http.StatusOK && status != http.StatusNoContent && status != http.StatusNotModified
+}
+
+// compressible - тип ещё не сжат (по media type без параметров).
+func compressible(contentType string) bool {
+	mt, _, _ := strings.Cut(strings.ToLower(contentType), ";")
+	mt = strings.TrimSpace(mt)
+
+	switch {
+	case mt == "image/svg+xml":
+		return true
+	case strings.HasPrefix(mt, "image/"), strings.HasPrefix(mt, "video/"),
+		strings.HasPrefix(mt, "audio/"), strings.HasPrefix(mt, "font/woff"):
+		return false
+	}
+	switch mt {
+	case "application/zip", "application/gzip", "application/x-gzip",
+		"application/zstd", "application/x-bzip2", "application/x-xz",
+		"application/x-7z-compressed", "application/x-rar-compressed",
+		"application/vnd.rar", "application/pdf":
+		return false
+	}
+	return true
+}
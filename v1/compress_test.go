@@ -0,0 +1,156 @@
+package httpx
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// serveCompress - ответ handler через Compress с Accept-Encoding: gzip.
+func serveCompress(handler http.HandlerFunc) *httptest.ResponseRecorder {
+	return get(Compress(handler), "/", "Accept-Encoding", "gzip")
+}
+
+func gunzip(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestCompress(t *testing.T) {
+	body := strings.Repeat(`{"name":"article"}`, 200)
+	rec := serveCompress(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", "3600")
+		w.Header().Set("ETag", `"abc"`)
+		_, _ = w.Write([]byte(body[:100])) // тело частями: решение после CompressMinSize
+		_, _ = w.Write([]byte(body[100:]))
+	})
+
+	h := rec.Header()
+	if h.Get("Content-Encoding") != "gzip" || h.Get("Content-Length") != "" {
+		t.Fatalf("headers = %v", h)
+	}
+	if h.Get("ETag") != `W/"abc"` {
+		t.Errorf("ETag = %q, want weak", h.Get("ETag"))
+	}
+	if h.Get("Vary") != "Accept-Encoding" {
+		t.Errorf("Vary = %q", h.Get("Vary"))
+	}
+	if got := gunzip(t, rec); got != body {
+		t.Errorf("decoded body differs: %d bytes, want %d", len(got), len(body))
+	}
+}
+
+func TestCompressSkips(t *testing.T) {
+	large := strings.Repeat("a", 4096)
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{"small", func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write([]byte("tiny")) }},
+		{"no content", func(w http.ResponseWriter, r *http.Request) { NoContent(w, r) }},
+		{"image", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write([]byte(large))
+		}},
+		{"encoded", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "br")
+			_, _ = w.Write([]byte(large))
+		}},
+		{"no-transform", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Cache-Control", "no-transform")
+			_, _ = w.Write([]byte(large))
+		}},
+		{"partial", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Range", "bytes 0-4095/8192")
+			w.WriteHeader(http.StatusPartialContent)
+			_, _ = w.Write([]byte(large))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveCompress(tt.handler)
+			if enc := rec.Header().Get("Content-Encoding"); enc == "gzip" {
+				t.Errorf("Content-Encoding = gzip, want uncompressed")
+			}
+			if strings.HasPrefix(rec.Body.String(), "\x1f\x8b") {
+				t.Error("body is gzipped")
+			}
+		})
+	}
+}
+
+func TestCompressFlush(t *testing.T) {
+	rec := serveCompress(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("data: 1\n\n"))
+		_ = http.NewResponseController(w).Flush()
+		_, _ = w.Write([]byte("data: 2\n\n"))
+	})
+	if !rec.Flushed || rec.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("flushed = %v headers = %v", rec.Flushed, rec.Header())
+	}
+	if got := gunzip(t, rec); got != "data: 1\n\ndata: 2\n\n" {
+		t.Errorf("body = %q", got)
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		accept, want string
+	}{
+		{"", ""},
+		{"gzip", "gzip"},
+		{"GZIP", "gzip"},
+		{"deflate, gzip;q=0.5", "gzip"},
+		{"gzip;q=0", ""},
+		{"*", "gzip"},
+		{"*;q=0", ""},
+		{"identity", ""},
+	}
+	for _, tt := range tests {
+		if got, _ := negotiateEncoding(tt.accept); got != tt.want {
+			t.Errorf("negotiateEncoding(%q) = %q, want %q", tt.accept, got, tt.want)
+		}
+	}
+}
+
+func TestCompressConcurrent(t *testing.T) {
+	h := Compress(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat(r.URL.Query().Get("q"), 2048)))
+	}))
+
+	var wg sync.WaitGroup
+	for _, q := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				rec := get(h, "/?q="+q, "Accept-Encoding", "gzip")
+				zr, err := gzip.NewReader(rec.Body)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				b, _ := io.ReadAll(zr)
+				if string(b) != strings.Repeat(q, 2048) {
+					t.Errorf("q=%s: pooled encoder mixed bodies", q)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,128 @@
+package httpx
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Version - текущая версия ресурса для условных запросов на запись.
+// ETag можно передавать без кавычек ("v42" → "\"v42\"").
+type Version struct {
+	ETag     string
+	Modified time.Time
+}
+
+// CheckPrecondition сверяет If-Match / If-Unmodified-Since с текущей версией
+// ресурса перед записью (оптимистичная блокировка, RFC 9110 §13.1).
+//
+// Возвращает true, если писать можно (условие совпало или не передано).
+// Иначе сам отвечает 412 PRECONDITION_FAILED с актуальным ETag в заголовке
+// и в details: {"etag": "…", "last_modified": "…"} - и возвращает false.
+// Обязательность заголовков задаётся на роуте через RequirePrecondition.
+//
+// Пример:
+//
+//	doc, _ := repo.Get(ctx, id)
+//	if !httpx.CheckPrecondition(w, r, httpx.Version{ETag: doc.Rev, Modified: doc.UpdatedAt}) {
+//	    return
+//	}
+//	// … обновляем doc
+func CheckPrecondition(w http.ResponseWriter, r *http.Request, current Version) bool {
+	etag := quoteETag(current.ETag)
+
+	ok := true
+	if im := r.Header.Get("If-Match"); im != "" {
+		ok = etag != "" && etagListMatches(im, etag, true)
+	} else if ius := r.Header.Get("If-Unmodified-Since"); ius != "" && !current.Modified.IsZero() {
+		// Без даты изменения заголовок игнорируется (RFC 9110 §13.1.4).
+		t, err := http.ParseTime(ius)
+		ok = err == nil && !current.Modified.Truncate(time.Second).After(t)
+	}
+	if ok {
+		return true
+	}
+
+	details := map[string]string{}
+	if etag != "" {
+		w.Header().Set("ETag", etag)
+		details["etag"] = etag
+	}
+	if !current.Modified.IsZero() {
+		lm := current.Modified.UTC().Format(http.TimeFormat)
+		w.Header().Set("Last-Modified", lm)
+		details["last_modified"] = lm
+	}
+	Error(w, r, http.StatusPreconditionFailed, ErrorCode(http.StatusPreconditionFailed), "Resource has been modified", details)
+	return false
+}
+
+// RequirePrecondition - middleware для роутов с обязательной оптимистичной
+// блокировкой: PUT, PATCH и DELETE без If-Match и If-Unmodified-Since
+// получают 428 PRECONDITION_REQUIRED.
+//
+// Пример:
+//
+//	r.With(httpx.RequirePrecondition).Put("/docs/{id}", updateDoc)
+func RequirePrecondition(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut, http.MethodPatch, http.MethodDelete:
+			if r.Header.Get("If-Match") == "" && r.Header.Get("If-Unmodified-Since") == "" {
+				ErrorPreconditionRequired(w, r, "If-Match header is required")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// quoteETag добавляет кавычки к «голому» значению версии.
+func quoteETag(etag string) string {
+	if etag == "" || strings.HasPrefix(etag, `"`) || strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return `"` + etag + `"`
+}
+
+// notModified - условный GET/HEAD (RFC 9110 §13): true, если у клиента
+// актуальная версия и можно ответить 304.
+//
+// If-None-Match сверяется с etag (слабое сравнение, "*" - любая версия);
+// If-Modified-Since - с modtime и учитывается только без If-None-Match.
+func notModified(r *http.Request, etag string, modtime time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		return etag != "" && etagListMatches(inm, etag, false)
+	}
+
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !modtime.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !modtime.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+// etagListMatches ищет etag в списке заголовка ("a", W/"b", *).
+// strong - сильное сравнение: слабые теги не совпадают ни с чем.
+func etagListMatches(list, etag string, strong bool) bool {
+	if strings.TrimSpace(list) == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if strong {
+			if tag == etag && !strings.HasPrefix(tag, "W/") {
+				return true
+			}
+			continue
+		}
+		if strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCheckPrecondition(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+	current := Version{ETag: "v2", Modified: modified}
+
+	tests := []struct {
+		name   string
+		header []string
+		ok     bool
+	}{
+		{"no conditions", nil, true},
+		{"if-match", []string{"If-Match", `"v2"`}, true},
+		{"if-match list", []string{"If-Match", `"v1", "v2"`}, true},
+		{"if-match any", []string{"If-Match", "*"}, true},
+		{"if-match stale", []string{"If-Match", `"v1"`}, false},
+		{"if-match weak", []string{"If-Match", `W/"v2"`}, false},
+		{"unmodified since", []string{"If-Unmodified-Since", modified.Format(http.TimeFormat)}, true},
+		{"modified since", []string{"If-Unmodified-Since", modified.Add(-time.Hour).Format(http.TimeFormat)}, false},
+		{"bad date", []string{"If-Unmodified-Since", "yesterday"}, false},
+		{"if-match wins", []string{"If-Match", `"v2"`, "If-Unmodified-Since", modified.Add(-time.Hour).Format(http.TimeFormat)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/", nil)
+			for i := 0; i+1 < len(tt.header); i += 2 {
+				r.Header.Set(tt.header[i], tt.header[i+1])
+			}
+			rec := httptest.NewRecorder()
+			if ok := CheckPrecondition(rec, r, current); ok != tt.ok {
+				t.Fatalf("CheckPrecondition = %v, want %v", ok, tt.ok)
+			}
+			if tt.ok {
+				return
+			}
+
+			if rec.Code != http.StatusPreconditionFailed {
+				t.Fatalf("status = %d, want 412", rec.Code)
+			}
+			if rec.Header().Get("ETag") != `"v2"` || rec.Header().Get("Last-Modified") != modified.Format(http.TimeFormat) {
+				t.Errorf("headers = %v", rec.Header())
+			}
+			b := decodeError(t, rec)
+			if b.Error.Code != ErrorCode(http.StatusPreconditionFailed) {
+				t.Errorf("code = %q", b.Error.Code)
+			}
+			var details map[string]string
+			if err := json.Unmarshal(b.Error.Details, &details); err != nil || details["etag"] != `"v2"` || details["last_modified"] == "" {
+				t.Errorf("details = %s", b.Error.Details)
+			}
+		})
+	}
+}
+
+func TestCheckPreconditionNoModified(t *testing.T) {
+	// дата изменения неизвестна - If-Unmodified-Since игнорируется
+	r := httptest.NewRequest(http.MethodPut, "/", nil)
+	r.Header.Set("If-Unmodified-Since", "Sun, 01 Mar 2026 12:00:00 GMT")
+	rec := httptest.NewRecorder()
+	if !CheckPrecondition(rec, r, Version{ETag: "v2"}) {
+		t.Fatalf("status = %d, want write allowed", rec.Code)
+	}
+}
+
+func TestRequirePrecondition(t *testing.T) {
+	h := RequirePrecondition(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		NoContent(w, r)
+	}))
+
+	tests := []struct {
+		method string
+		header []string
+		status int
+	}{
+		{http.MethodGet, nil, http.StatusNoContent},
+		{http.MethodPost, nil, http.StatusNoContent},
+		{http.MethodPut, nil, http.StatusPreconditionRequired},
+		{http.MethodPatch, nil, http.StatusPreconditionRequired},
+		{http.MethodDelete, nil, http.StatusPreconditionRequired},
+		{http.MethodPut, []string{"If-Match", `"v1"`}, http.StatusNoContent},
+		{http.MethodDelete, []string{"If-Unmodified-Since", "Sun, 01 Mar 2026 12:00:00 GMT"}, http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, "/", nil)
+		for i := 0; i+1 < len(tt.header); i += 2 {
+			r.Header.Set(tt.header[i], tt.header[i+1])
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		if rec.Code != tt.status {
+			t.Errorf("%s %v: status %d, want %d", tt.method, tt.header, rec.Code, tt.status)
+		}
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	modtime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		method, inm, ims string
+		want             bool
+	}{
+		{http.MethodGet, `"a"`, "", true},
+		{http.MethodGet, `W/"a"`, "", true}, // слабое сравнение
+		{http.MethodGet, `"b"`, modtime.Format(http.TimeFormat), false},
+		{http.MethodGet, "", modtime.Format(http.TimeFormat), true},
+		{http.MethodGet, "", modtime.Add(-time.Second).Format(http.TimeFormat), false},
+		{http.MethodHead, "*", "", true},
+		{http.MethodPost, `"a"`, "", false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, "/", nil)
+		if tt.inm != "" {
+			r.Header.Set("If-None-Match", tt.inm)
+		}
+		if tt.ims != "" {
+			r.Header.Set("If-Modified-Since", tt.ims)
+		}
+		if got := notModified(r, `"a"`, modtime); got != tt.want {
+			t.Errorf("%s INM=%q IMS=%q: %v, want %v", tt.method, tt.inm, tt.ims, got, tt.want)
+		}
+	}
+}
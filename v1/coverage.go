@@ -0,0 +1,164 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// errValidatorLayout - внутренние таблицы validator устроены не так, как
+// ожидает TranslationCoverage (другая версия библиотеки).
+var errValidatorLayout = errors.New("httpx: unsupported validator version")
+
+// OnTranslationFallback вызывается из BindValidate, когда для правила нет
+// перевода и validator вернул текст по умолчанию («Key: ... Error: ...»).
+//
+// Назначайте при старте сервиса - например, чтобы алертить о непереведённых
+// сообщениях:
+//
+//	httpx.OnTranslationFallback = func(r *http.Request, locale string, fe validator.FieldError) {
+//	    slog.Warn("untranslated validation message", "locale", locale, "tag", fe.Tag())
+//	}
+var OnTranslationFallback func(r *http.Request, locale string, fe validator.FieldError)
+
+// TranslationGap - тег правила без перевода в конкретном языке.
+type TranslationGap struct {
+	Locale string `json:"locale"`
+	Tag    string `json:"tag"`
+}
+
+// TranslationCoverage сверяет все зарегистрированные в V теги (встроенные,
+// алиасы, кастомные) со всеми разрешёнными языками и возвращает пробелы,
+// отсортированные по языку и тегу. Языки при этом загружаются.
+//
+// Если передать tags, проверяются только они (например, свои кастомные правила).
+// Если версия validator не позволяет прочитать его таблицы, результат пустой -
+// CheckTranslations в этом случае падает.
+func TranslationCoverage(tags ...string) []TranslationGap {
+	gaps, _ := translationCoverage(tags)
+	return gaps
+}
+
+func translationCoverage(tags []string) ([]TranslationGap, error) {
+	if V == nil {
+		return nil, errValidatorUnset
+	}
+	_ = LoadLocales()
+
+	rv := reflect.ValueOf(V).Elem()
+	if len(tags) == 0 {
+		var err error
+		if tags, err = registeredTags(rv); err != nil {
+			return nil, err
+		}
+	}
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	codes := make([]string, 0, len(translators))
+	for code := range translators {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var gaps []TranslationGap
+	for _, code := range codes {
+		have, err := translatedTags(rv, translators[code])
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range tags {
+			if !have[tag] {
+				gaps = append(gaps, TranslationGap{Locale: code, Tag: tag})
+			}
+		}
+	}
+	return gaps, nil
+}
+
+// TestingT - подмножество testing.TB, нужное CheckTranslations
+// (чтобы не тянуть пакет testing в прод-код).
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// CheckTranslations - хелпер для тестов: падает на каждом непереведённом теге.
+//
+//	func TestTranslations(t *testing.T) {
+//	    registerRules() // RegisterCustomValidator(...)
+//	    httpx.CheckTranslations(t, "tz", "slug")
+//	}
+func CheckTranslations(t TestingT, tags ...string) {
+	t.Helper()
+	gaps, err := translationCoverage(tags)
+	if err != nil {
+		t.Errorf("%v", err)
+		return
+	}
+	for _, gap := range gaps {
+		t.Errorf("httpx: no %q translation for tag %q", gap.Locale, gap.Tag)
+	}
+}
+
+// registeredTags - все теги и алиасы валидатора (rv - *validator.Validate
+// после Elem()), отсортированные.
+//
+// validator не отдаёт список правил наружу, поэтому читаем его
+// неэкспортируемые поля через reflect (только чтение). Если в новой версии
+// validator поля устроены иначе - errValidatorLayout, а не паника.
+func registeredTags(rv reflect.Value) ([]string, error) {
+	var tags []string
+	for _, name := range []string{"validations", "aliases"} {
+		keys, err := stringKeys(rv.FieldByName(name), name)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, keys...)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+var translatorType = reflect.TypeFor[ut.Translator]()
+
+// translatedTags - теги, для которых в валидаторе rv зарегистрирован перевод на tr.
+func translatedTags(rv reflect.Value, tr ut.Translator) (map[string]bool, error) {
+	funcs := rv.FieldByName("transTagFunc")
+	if !funcs.IsValid() || funcs.Kind() != reflect.Map || funcs.Type().Key() != translatorType {
+		return nil, fmt.Errorf("%w: transTagFunc", errValidatorLayout)
+	}
+
+	byTag := funcs.MapIndex(reflect.ValueOf(&tr).Elem())
+	if !byTag.IsValid() {
+		return nil, nil
+	}
+
+	keys, err := stringKeys(byTag, "transTagFunc")
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		tags[key] = true
+	}
+	return tags, nil
+}
+
+// stringKeys - ключи m, если это map со строковыми ключами.
+func stringKeys(m reflect.Value, name string) ([]string, error) {
+	if !m.IsValid() || m.Kind() != reflect.Map || m.Type().Key().Kind() != reflect.String {
+		return nil, fmt.Errorf("%w: %s", errValidatorLayout, name)
+	}
+	keys := make([]string, 0, m.Len())
+	for _, key := range m.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	return keys, nil
+}
@@ -0,0 +1,88 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestRegisteredTags(t *testing.T) {
+	tags, err := registeredTags(reflect.ValueOf(V).Elem())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tag := range []string{"required", "email", "nohtml", "iscolor"} { // iscolor - алиас
+		if !slices.Contains(tags, tag) {
+			t.Errorf("tag %q missing", tag)
+		}
+	}
+
+	tr, _ := translator(fallbackLocale)
+	have, err := translatedTags(reflect.ValueOf(V).Elem(), tr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !have["required"] {
+		t.Error(`"required" has no en translation`)
+	}
+}
+
+func TestValidatorLayoutChanged(t *testing.T) {
+	tr, _ := translator(fallbackLocale)
+	layouts := map[string]any{
+		"no fields": struct{}{},
+		"wrong types": struct {
+			validations  []string
+			aliases      map[int]string
+			transTagFunc map[string]string
+		}{},
+		"wrong translation map": struct {
+			transTagFunc map[ut.Translator][]validator.TranslationFunc
+		}{transTagFunc: map[ut.Translator][]validator.TranslationFunc{tr: nil}},
+	}
+	for name, layout := range layouts {
+		t.Run(name, func(t *testing.T) {
+			rv := reflect.ValueOf(&layout).Elem().Elem()
+			if _, err := registeredTags(rv); !errors.Is(err, errValidatorLayout) {
+				t.Errorf("registeredTags err = %v, want errValidatorLayout", err)
+			}
+			if _, err := translatedTags(rv, tr); !errors.Is(err, errValidatorLayout) {
+				t.Errorf("translatedTags err = %v, want errValidatorLayout", err)
+			}
+		})
+	}
+}
+
+// fakeT собирает ошибки CheckTranslations.
+type fakeT struct{ errors []string }
+
+func (ft *fakeT) Helper() {}
+
+func (ft *fakeT) Errorf(format string, args ...any) {
+	ft.errors = append(ft.errors, fmt.Sprintf(format, args...))
+}
+
+func TestCheckTranslations(t *testing.T) {
+	ft := &fakeT{}
+	CheckTranslations(ft, "required")
+	if len(ft.errors) != 0 {
+		t.Errorf("required: %v", ft.errors)
+	}
+
+	ft = &fakeT{}
+	CheckTranslations(ft, "no_such_rule")
+	if len(ft.errors) == 0 {
+		t.Fatal("no error for untranslated tag")
+	}
+	for _, msg := range ft.errors {
+		if !strings.Contains(msg, `"no_such_rule"`) {
+			t.Errorf("unexpected error %q", msg)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"slices"
+	"strings"
+)
+
+// Debug - режим разработки: 5xx-ответы получают в details цепочку ошибок,
+// стек и снимок запроса, а сообщение остаётся как передано.
+//
+// Без Debug (production) внутренние детали в ответ не попадают, а
+// сообщение любого 5xx заменяется общим локализованным текстом (кроме
+// статусов из PublicServerErrors). По умолчанию включён только в сборке
+// с `-tags httpx_debug`.
+//
+// Пример:
+//
+//	httpx.Debug = os.Getenv("APP_ENV") == "local"
+var Debug = debugBuild
+
+// PublicServerErrors - 5xx-статусы, чьё сообщение в production уходит
+// клиенту как передано (например, окно обслуживания в 503). По умолчанию
+// пусто: сообщение заменяется у всех 5xx.
+//
+// Пример:
+//
+//	httpx.PublicServerErrors = []int{http.StatusServiceUnavailable}
+var PublicServerErrors []int
+
+// sensitiveHeaders не показываются в снимке запроса даже в Debug.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "X-Api-Key"}
+
+// serverErrorMessages - общий текст 5xx для production по языкам.
+var serverErrorMessages = map[string]string{
+	"en": "Something went wrong on our side. Please try again later",
+	"ru": "Что-то пошло не так на нашей стороне. Попробуйте позже",
+	"de": "Bei uns ist etwas schiefgelaufen. Bitte versuchen Sie es später erneut",
+	"zh": "服务器出现问题，请稍后重试",
+	"fr": "Une erreur s'est produite de notre côté. Veuillez réessayer plus tard",
+	"es": "Algo salió mal de nuestro lado. Inténtalo de nuevo más tarde",
+	"it": "Si è verificato un problema da parte nostra. Riprova più tardi",
+	"pt": "Algo deu errado do nosso lado. Tente novamente mais tarde",
+	"ja": "サーバー側で問題が発生しました。しばらくしてから再度お試しください",
+	"ko": "서버에 문제가 발생했습니다. 잠시 후 다시 시도해 주세요",
+	"lv": "Mūsu pusē radās kļūda. Lūdzu, mēģiniet vēlāk",
+	"uk": "Щось пішло не так на нашому боці. Спробуйте пізніше",
+	"pl": "Coś poszło nie tak po naszej stronie. Spróbuj ponownie później",
+	"tr": "Bizim tarafımızda bir sorun oluştu. Lütfen daha sonra tekrar deneyin",
+	"ar": "حدث خطأ من جانبنا. يرجى المحاولة لاحقًا",
+	"he": "משהו השתבש אצלנו. נסו שוב מאוחר יותר",
+	"nl": "Er is aan onze kant iets misgegaan. Probeer het later opnieuw",
+}
+
+// debugDetails - details 5xx-ответа в режиме Debug.
+type debugDetails struct {
+	Details any             `json:"details,omitempty"` // details, переданные в Error
+	Causes  []errorCause    `json:"causes,omitempty"`  // цепочка ошибок от внешней к корневой
+	Stack   []string        `json:"stack,omitempty"`
+	Request requestSnapshot `json:"request"`
+}
+
+type errorCause struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type requestSnapshot struct {
+	Method     string            `json:"method"`
+	URL        string            `json:"url"`
+	Host       string            `json:"host"`
+	RemoteAddr string            `json:"remote_addr"`
+	Header     map[string]string `json:"header,omitempty"`
+}
+
+// ErrorInternalCause - ErrorInternal с причиной err. В режиме Debug
+// цепочка err (errors.Unwrap, включая errors.Join) и стек уходят в details;
+// в production клиент видит только общий текст.
+//
+// Status: 500 Internal Server Error
+// Code:   INTERNAL
+//
+// Пример:
+//
+//	if err := repo.Save(ctx, doc); err != nil {
+//	    httpx.ErrorInternalCause(w, r, "Cannot save document", err)
+//	    return
+//	}
+func ErrorInternalCause(w http.ResponseWriter, r *http.Request, msg string, err error) {
+	writeError(w, r, http.StatusInternalServerError, ErrorCode(http.StatusInternalServerError), msg, nil, err, nil)
+}
+
+// serverErrorBody готовит message и details 5xx-ответа под текущий режим.
+// stack == nil - берётся стек вызова.
+func serverErrorBody(r *http.Request, status int, message string, details any, cause error, stack []byte) (string, any) {
+	if !Debug {
+		if !slices.Contains(PublicServerErrors, status) {
+			message = serverErrorMessage(r)
+		}
+		return message, details
+	}
+
+	if stack == nil {
+		stack = debug.Stack()
+	}
+	var lines []string
+	for _, l := range strings.Split(strings.TrimSpace(string(stack)), "\n") {
+		lines = append(lines, strings.TrimSpace(l))
+	}
+
+	return message, debugDetails{
+		Details: details,
+		Causes:  errorChain(cause),
+		Stack:   lines,
+		Request: snapshotRequest(r),
+	}
+}
+
+// serverErrorMessage - общий текст на языке запроса.
+func serverErrorMessage(r *http.Request) string {
+	if tr := TranslatorFor(r); tr != nil {
+		if msg, ok := serverErrorMessages[baseLocale(tr.Locale())]; ok {
+			return msg
+		}
+	}
+	return serverErrorMessages[fallbackLocale]
+}
+
+// errorChain раскрывает err в список причин (обход в глубину, не больше 32).
+func errorChain(err error) []errorCause {
+	var chain []errorCause
+	var walk func(error)
+	walk = func(err error) {
+		for err != nil && len(chain) < 32 {
+			chain = append(chain, errorCause{Type: fmt.Sprintf("%T", err), Message: err.Error()})
+			if joined, ok := err.(interface{ Unwrap() []error }); ok {
+				for _, e := range joined.Unwrap() {
+					walk(e)
+				}
+				return
+			}
+			err = errors.Unwrap(err)
+		}
+	}
+	walk(err)
+	return chain
+}
+
+// snapshotRequest - метод, URL и заголовки без sensitiveHeaders.
+func snapshotRequest(r *http.Request) requestSnapshot {
+	s := requestSnapshot{
+		Method:     r.Method,
+		URL:        r.URL.RequestURI(),
+		Host:       r.Host,
+		RemoteAddr: r.RemoteAddr,
+		Header:     make(map[string]string, len(r.Header)),
+	}
+
+	for k, vs := range r.Header {
+		v := strings.Join(vs, ", ")
+		for _, sh := range sensitiveHeaders {
+			if strings.EqualFold(k, sh) {
+				v = "[REDACTED]"
+				break
+			}
+		}
+		s.Header[k] = v
+	}
+	return s
+}
@@ -0,0 +1,5 @@
+//go:build !httpx_debug
+
+package httpx
+
+const debugBuild = false
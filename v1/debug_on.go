@@ -0,0 +1,6 @@
+//go:build httpx_debug
+
+package httpx
+
+// Сборка с `-tags httpx_debug` включает Debug по умолчанию.
+const debugBuild = true
@@ -0,0 +1,133 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// withDebug переключает Debug на время теста.
+func withDebug(t *testing.T, on bool) {
+	t.Helper()
+	prev := Debug
+	Debug = on
+	t.Cleanup(func() { Debug = prev })
+}
+
+// errorBody - ответ с ошибкой; details оставлены сырыми для проверки полей.
+type errorBody struct {
+	Error struct {
+		Code    string          `json:"code"`
+		Message string          `json:"message"`
+		Details json.RawMessage `json:"details"`
+	} `json:"error"`
+}
+
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) errorBody {
+	t.Helper()
+	var b errorBody
+	if err := json.Unmarshal(rec.Body.Bytes(), &b); err != nil {
+		t.Fatalf("decode %s: %v", rec.Body.String(), err)
+	}
+	return b
+}
+
+func TestServerErrorBodyProduction(t *testing.T) {
+	withDebug(t, false)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Language", "ru")
+	msg, details := serverErrorBody(r, http.StatusInternalServerError, "db: conn refused", nil, errors.New("boom"), nil)
+	// со сборкой httpx_minimal ru нет - ответ на английском
+	if want := serverErrorMessages[TranslatorFor(r).Locale()]; msg != want {
+		t.Errorf("500 message = %q, want %q", msg, want)
+	}
+	if details != nil {
+		t.Errorf("500 details = %#v, want nil", details)
+	}
+
+	for _, status := range []int{http.StatusNotImplemented, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		msg, _ := serverErrorBody(r, status, "upstream 10.0.0.7: EOF", nil, nil, nil)
+		if want := serverErrorMessages[TranslatorFor(r).Locale()]; msg != want {
+			t.Errorf("%d message = %q, want %q", status, msg, want)
+		}
+	}
+}
+
+func TestServerErrorBodyDebug(t *testing.T) {
+	withDebug(t, true)
+
+	r := httptest.NewRequest(http.MethodGet, "/docs/1?x=1", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	cause := fmt.Errorf("save: %w", errors.Join(fs.ErrNotExist, errors.New("db down")))
+
+	msg, details := serverErrorBody(r, http.StatusInternalServerError, "Cannot save", "extra", cause, nil)
+	if msg != "Cannot save" {
+		t.Errorf("message = %q, want original", msg)
+	}
+	d, ok := details.(debugDetails)
+	if !ok {
+		t.Fatalf("details = %T, want debugDetails", details)
+	}
+	if d.Details != "extra" {
+		t.Errorf("Details = %#v, want caller details", d.Details)
+	}
+	if len(d.Causes) != 4 || d.Causes[2].Message != fs.ErrNotExist.Error() {
+		t.Errorf("Causes = %+v", d.Causes)
+	}
+	if len(d.Stack) == 0 {
+		t.Error("Stack is empty")
+	}
+	if d.Request.URL != "/docs/1?x=1" || d.Request.Header["Authorization"] != "[REDACTED]" {
+		t.Errorf("Request = %+v", d.Request)
+	}
+}
+
+func TestErrorInternalCause(t *testing.T) {
+	cause := errors.New("conn refused")
+	for _, debug := range []bool{false, true} {
+		t.Run(fmt.Sprint("debug=", debug), func(t *testing.T) {
+			withDebug(t, debug)
+
+			rec := httptest.NewRecorder()
+			ErrorInternalCause(rec, httptest.NewRequest(http.MethodGet, "/", nil), "Cannot save", cause)
+			b := decodeError(t, rec)
+
+			if rec.Code != http.StatusInternalServerError || b.Error.Code != "INTERNAL" {
+				t.Fatalf("status %d code %s", rec.Code, b.Error.Code)
+			}
+			leaked := strings.Contains(rec.Body.String(), "conn refused") ||
+				strings.Contains(rec.Body.String(), "Cannot save")
+			if leaked != debug {
+				t.Fatalf("internals in body = %v, want %v: %s", leaked, debug, rec.Body.String())
+			}
+			if !debug && len(b.Error.Details) != 0 {
+				t.Fatalf("production details = %s, want none", b.Error.Details)
+			}
+		})
+	}
+}
+
+func TestPublicServerErrors(t *testing.T) {
+	withDebug(t, false)
+	prev := PublicServerErrors
+	PublicServerErrors = []int{http.StatusServiceUnavailable}
+	t.Cleanup(func() { PublicServerErrors = prev })
+
+	rec := httptest.NewRecorder()
+	ErrorServiceUnavailable(rec, httptest.NewRequest(http.MethodGet, "/", nil), "Maintenance until 15:00")
+	if b := decodeError(t, rec); b.Error.Message != "Maintenance until 15:00" {
+		t.Errorf("503 message = %q, want caller message", b.Error.Message)
+	}
+
+	rec = httptest.NewRecorder()
+	ErrorBadGateway(rec, httptest.NewRequest(http.MethodGet, "/", nil), "upstream 10.0.0.7: EOF")
+	if b := decodeError(t, rec); b.Error.Message != serverErrorMessages[fallbackLocale] {
+		t.Errorf("502 message = %q, want generic", b.Error.Message)
+	}
+}
@@ -0,0 +1,160 @@
+package httpx
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errBadDefault - значение тега default не разбирается в тип поля.
+var errBadDefault = errors.New("httpx: invalid default value")
+
+var (
+	durationType        = reflect.TypeFor[time.Duration]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// ApplyDefaults заполняет нулевые поля dst значениями из тега default.
+// BindValidate вызывает её сама; для своих биндеров (query, path)
+// вызывайте перед валидацией.
+//
+// Поддерживаются: string, bool, int*/uint*/float*, time.Duration ("1m30s"),
+// time.Time (RFC 3339 или 2006-01-02), encoding.TextUnmarshaler, указатели
+// на них и слайсы (элементы через запятую). Вложенные структуры обходятся.
+//
+//	type ListQuery struct {
+//	    Limit   int           `default:"20"`
+//	    Sort    []string      `default:"-created_at,id"`
+//	    Timeout time.Duration `default:"5s"`
+//	    Strict  *bool         `default:"true"` // указатель: явный false не перезапишется
+//	}
+//
+// Нулевое значение неотличимо от пропущенного: если 0/false/"" допустимы
+// как явный ввод - используйте указатель.
+func ApplyDefaults(dst any) error {
+	return applyDefaults(reflect.ValueOf(dst))
+}
+
+func applyDefaults(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			return applyDefaults(v.Elem())
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return nil
+		}
+		t := v.Type()
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+
+			def, ok := sf.Tag.Lookup("default")
+			if !ok {
+				if err := applyDefaults(v.Field(i)); err != nil {
+					return err
+				}
+				continue
+			}
+			if !v.Field(i).IsZero() {
+				continue
+			}
+			if err := setDefault(v.Field(i), def); err != nil {
+				return fmt.Errorf("%w for %s.%s: %v", errBadDefault, t.Name(), sf.Name, err)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			if err := applyDefaults(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setDefault разбирает s в тип поля v и записывает результат.
+func setDefault(v reflect.Value, s string) error {
+	if v.Kind() == reflect.Pointer {
+		p := reflect.New(v.Type().Elem())
+		if err := setDefault(p.Elem(), s); err != nil {
+			return err
+		}
+		v.Set(p)
+		return nil
+	}
+
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) && v.Type() != timeType {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	case v.Type() == timeType:
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			if t, err = time.Parse(time.DateOnly, s); err != nil {
+				return err
+			}
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if s == "" {
+			return nil
+		}
+		parts := strings.Split(s, ",")
+		sl := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setDefault(sl.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		v.Set(sl)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
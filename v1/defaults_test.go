@@ -0,0 +1,111 @@
+package httpx
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// level - TextUnmarshaler для проверки default.
+type level int
+
+func (l *level) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+func TestApplyDefaults(t *testing.T) {
+	type paging struct {
+		Limit uint8 `default:"20"`
+	}
+	type query struct {
+		Name    string        `default:"anon"`
+		Limit   int           `default:"20"`
+		Ratio   float64       `default:"0.5"`
+		Strict  *bool         `default:"true"`
+		Sort    []string      `default:"-created_at, id"`
+		IDs     []int         `default:"1,2"`
+		Timeout time.Duration `default:"1m30s"`
+		Since   time.Time     `default:"2026-01-02"`
+		Until   time.Time     `default:"2026-01-02T03:04:05Z"`
+		Level   level         `default:"high"`
+		IP      net.IP        `default:"10.0.0.1"`
+		Page    paging
+		Next    *paging
+		Set     int `default:"7"`
+		NoTag   int
+	}
+
+	strict := false
+	q := query{Strict: &strict, Set: 3, Next: &paging{}}
+	if err := ApplyDefaults(&q); err != nil {
+		t.Fatal(err)
+	}
+
+	want := query{
+		Name:    "anon",
+		Limit:   20,
+		Ratio:   0.5,
+		Strict:  &strict, // явный false не перезаписан
+		Sort:    []string{"-created_at", "id"},
+		IDs:     []int{1, 2},
+		Timeout: 90 * time.Second,
+		Since:   time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		Until:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Level:   2,
+		IP:      net.ParseIP("10.0.0.1"),
+		Page:    paging{Limit: 20},
+		Next:    &paging{Limit: 20},
+		Set:     3,
+	}
+	if !reflect.DeepEqual(q, want) {
+		t.Errorf("got  %+v\nwant %+v", q, want)
+	}
+	if *q.Strict {
+		t.Error("explicit false overwritten")
+	}
+
+	var p struct {
+		Strict *bool `default:"true"`
+	}
+	if err := ApplyDefaults(&p); err != nil || p.Strict == nil || !*p.Strict {
+		t.Errorf("nil pointer default: %v, err %v", p.Strict, err)
+	}
+}
+
+func TestApplyDefaultsErrors(t *testing.T) {
+	tests := map[string]struct {
+		zero any
+		def  string
+	}{
+		"int":      {0, "many"},
+		"overflow": {int8(0), "300"},
+		"duration": {time.Duration(0), "soon"},
+		"time":     {time.Time{}, "tomorrow"},
+		"text":     {level(0), "mid"},
+		"slice":    {[]int(nil), "1,x"},
+		"type":     {make(chan int), "1"},
+	}
+	for name, tt := range tests {
+		v := reflect.New(reflect.TypeOf(tt.zero)).Elem()
+		if err := setDefault(v, tt.def); err == nil {
+			t.Errorf("%s: default %q accepted", name, tt.def)
+		}
+	}
+
+	var dst struct {
+		N int `default:"many"`
+	}
+	if err := ApplyDefaults(&dst); !errors.Is(err, errBadDefault) {
+		t.Errorf("err = %v, want errBadDefault", err)
+	}
+}
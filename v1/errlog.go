@@ -0,0 +1,117 @@
+package httpx
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+)
+
+// ErrorLogger - логгер всех ответов с ошибками (каждый вызов Error и
+// хелперов Error*). nil - ничего не логируется.
+//
+// Запись "http error" содержит status, code, message (исходное, до замены
+// в production), trace_id, method, path, route (шаблон chi), locale,
+// details (с RedactKeys) и error - причину из ErrorInternalCause / Recover.
+//
+// Пример:
+//
+//	httpx.ErrorLogger = slog.New(slog.NewJSONHandler(os.Stderr, nil))
+var ErrorLogger *slog.Logger
+
+// ErrorLogLevel - уровень записи по статусу. По умолчанию 5xx - Error,
+// 4xx - Warn, остальное - Info.
+var ErrorLogLevel = func(status int) slog.Level {
+	switch {
+	case status >= http.StatusInternalServerError:
+		return slog.LevelError
+	case status >= http.StatusBadRequest:
+		return slog.LevelWarn
+	}
+	return slog.LevelInfo
+}
+
+// RedactKeys - ключи details, значения которых в лог не попадают
+// (сравнение без учёта регистра, по вхождению: "token" скрывает и
+// "access_token").
+var RedactKeys = []string{"password", "token", "secret", "authorization", "cookie", "api_key", "card"}
+
+// logError пишет ответ с ошибкой в ErrorLogger.
+func logError(r *http.Request, status int, code, message string, details any, cause error) {
+	logger := ErrorLogger
+	if logger == nil {
+		return
+	}
+	ctx := r.Context()
+	level := ErrorLogLevel(status)
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.Int("status", status),
+		slog.String("code", code),
+		slog.String("message", message),
+		slog.String("trace_id", TraceID(r)),
+		slog.String("method", r.Method),
+		slog.String("path", r.URL.Path),
+	}
+	if rc := chi.RouteContext(ctx); rc != nil {
+		if pattern := rc.RoutePattern(); pattern != "" {
+			attrs = append(attrs, slog.String("route", pattern))
+		}
+	}
+	if tr := TranslatorFor(r); tr != nil {
+		attrs = append(attrs, slog.String("locale", tr.Locale()))
+	}
+	if details != nil {
+		attrs = append(attrs, slog.Any("details", redact(details)))
+	}
+	if cause != nil {
+		attrs = append(attrs, slog.Any("error", cause))
+	}
+	logger.LogAttrs(ctx, level, "http error", attrs...)
+}
+
+// redact - копия details (через JSON) со скрытыми значениями RedactKeys.
+func redact(details any) any {
+	b, err := json.Marshal(details)
+	if err != nil {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil
+	}
+	return redactValue(v)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, item := range v {
+			if sensitiveKey(k) {
+				v[k] = "[REDACTED]"
+				continue
+			}
+			v[k] = redactValue(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return v
+}
+
+func sensitiveKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range RedactKeys {
+		if strings.Contains(k, strings.ToLower(s)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package httpx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+)
+
+// withErrorLogger пишет ErrorLogger в буфер JSON-записями на время теста.
+func withErrorLogger(t *testing.T, level slog.Level) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	prev := ErrorLogger
+	ErrorLogger = slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: level}))
+	t.Cleanup(func() { ErrorLogger = prev })
+	return &buf
+}
+
+func logRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var records []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var rec map[string]any
+		if err := dec.Decode(&rec); err != nil {
+			t.Fatal(err)
+		}
+		records = append(records, rec)
+	}
+	return records
+}
+
+func TestErrorLogger(t *testing.T) {
+	withDebug(t, false)
+	withTraceSources(t, TraceHeader("X-Request-ID"))
+	buf := withErrorLogger(t, slog.LevelInfo)
+
+	r := chi.NewRouter()
+	r.Get("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		switch chi.URLParam(r, "id") {
+		case "0":
+			ErrorValidation(w, r, map[string]any{"email": "bad", "password": "hunter2", "nested": map[string]any{"access_token": "x"}})
+		case "1":
+			ErrorInternalCause(w, r, "db: conn refused", errors.New("dial tcp: refused"))
+		}
+	})
+	for _, path := range []string{"/users/0", "/users/1"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-Request-ID", "trace-1")
+		req.Header.Set("Accept-Language", "en")
+		r.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	records := logRecords(t, buf)
+	if len(records) != 2 {
+		t.Fatalf("records = %d, want 2", len(records))
+	}
+
+	warn := records[0]
+	if warn["level"] != "WARN" || warn["msg"] != "http error" || warn["status"] != float64(400) ||
+		warn["trace_id"] != "trace-1" || warn["route"] != "/users/{id}" || warn["path"] != "/users/0" || warn["locale"] != "en" {
+		t.Errorf("4xx record = %v", warn)
+	}
+	details, _ := warn["details"].(map[string]any)
+	nested, _ := details["nested"].(map[string]any)
+	if details["email"] != "bad" || details["password"] != "[REDACTED]" || nested["access_token"] != "[REDACTED]" {
+		t.Errorf("details = %v", details)
+	}
+
+	fail := records[1]
+	// в лог идёт исходное сообщение, а не общий текст production
+	if fail["level"] != "ERROR" || fail["message"] != "db: conn refused" || fail["error"] != "dial tcp: refused" {
+		t.Errorf("5xx record = %v", fail)
+	}
+}
+
+func TestErrorLoggerLevel(t *testing.T) {
+	buf := withErrorLogger(t, slog.LevelError)
+	ErrorNotFound(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), "user")
+	if buf.Len() != 0 {
+		t.Errorf("404 logged below ErrorLogger level: %s", buf.String())
+	}
+
+	prev := ErrorLogLevel
+	ErrorLogLevel = func(int) slog.Level { return slog.LevelError }
+	t.Cleanup(func() { ErrorLogLevel = prev })
+	ErrorNotFound(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil), "user")
+	if records := logRecords(t, buf); len(records) != 1 || records[0]["status"] != float64(404) {
+		t.Errorf("records = %v", records)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	type creds struct {
+		Login    string `json:"login"`
+		APIKey   string `json:"api_key"`
+		CardLast string `json:"CardNumber"`
+	}
+	got := redact([]creds{{Login: "neo", APIKey: "k", CardLast: "4242"}})
+	want := []any{map[string]any{"login": "neo", "api_key": "[REDACTED]", "CardNumber": "[REDACTED]"}}
+	if b1, b2 := mustJSON(t, got), mustJSON(t, want); b1 != b2 {
+		t.Errorf("redact = %s, want %s", b1, b2)
+	}
+	if redact(make(chan int)) != nil {
+		t.Error("unmarshalable details must be dropped")
+	}
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,169 @@
+package httpx
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+const maxETagBody = 4 << 20 // 4 MiB: ответы больше отдаём без буферизации и ETag
+
+// ETag - middleware условного GET: буферизует 200-ответы на GET/HEAD,
+// считает ETag по телу, ставит заголовок ETag и отвечает 304 через
+// RedirectNotModified, если совпал If-None-Match или If-Modified-Since
+// (с Last-Modified, выставленным хендлером).
+//
+// Валидатор сильный, только если тело побайтно одинаково. У Envelope
+// с trace_id байты отличаются от запроса к запросу, поэтому хеш
+// считается без trace_id и ETag всегда слабый W/"…" (If-Range по нему
+// не срабатывает, см. ServeRange).
+//
+// ETag, выставленный хендлером, не перезаписывается. Ответы больше 4 MiB
+// и потоковые (Flush) проходят без изменений.
+//
+// Пример:
+//
+//	r.With(httpx.ETag).Get("/articles/{id}", getArticle)
+func ETag(next http.Handler) http.Handler {
+	return etagHandler(next, false)
+}
+
+// WeakETag - как ETag, но с слабым валидатором W/"…": тело считается
+// семантически равным, а не побайтно (подходит, если ответ сжимается
+// или по-разному сериализуется прокси).
+func WeakETag(next http.Handler) http.Handler {
+	return etagHandler(next, true)
+}
+
+func etagHandler(next http.Handler, weak bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ew := &etagWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(ew, r)
+		if ew.passthrough {
+			return
+		}
+
+		h := w.Header()
+		if ew.status != http.StatusOK {
+			ew.flushBuffer()
+			return
+		}
+
+		etag := h.Get("ETag")
+		if etag == "" {
+			etag = computeETag(ew.buf.Bytes(), TraceID(r), weak)
+			h.Set("ETag", etag)
+		}
+
+		var modtime time.Time
+		if lm := h.Get("Last-Modified"); lm != "" {
+			modtime, _ = http.ParseTime(lm)
+		}
+
+		if notModified(r, etag, modtime) {
+			h.Del("Content-Type")
+			h.Del("Content-Length")
+			RedirectNotModified(w, r)
+			return
+		}
+		ew.flushBuffer()
+	})
+}
+
+// computeETag - sha256 тела (первые 128 бит) без поля trace_id; если
+// trace_id вырезан, ETag слабый.
+func computeETag(body []byte, traceID string, weak bool) string {
+	if rest, ok := cutTraceID(body, traceID); ok {
+		body, weak = rest, true
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	if weak {
+		etag = "W/" + etag
+	}
+	return etag
+}
+
+// cutTraceID убирает из тела Envelope поле trace_id со значением id.
+// trace_id - последнее поле объекта верхнего уровня, поэтому ищется
+// только в хвосте тела перед закрывающей скобкой: такой же текст внутри
+// data не затрагивается.
+func cutTraceID(body []byte, id string) ([]byte, bool) {
+	if id == "" || len(body) == 0 || body[0] != '{' {
+		return body, false
+	}
+	val, err := json.Marshal(id)
+	if err != nil {
+		return body, false
+	}
+	field := append([]byte(`,"trace_id":`), val...)
+	end := len(bytes.TrimRight(body, " \t\r\n")) - 1
+	if end < 0 || body[end] != '}' || !bytes.HasSuffix(body[:end], field) {
+		return body, false
+	}
+	out := make([]byte, 0, len(body)-len(field))
+	out = append(out, body[:end-len(field)]...)
+	return append(out, body[end:]...), true
+}
+
+// etagWriter копит статус и тело, пока ответ помещается в maxETagBody
+// и хендлер не вызвал Flush; после этого пишет напрямую.
+type etagWriter struct {
+	http.ResponseWriter
+	status      int
+	buf         bytes.Buffer
+	passthrough bool
+
+	onPassthrough func() // вызывается при переходе в passthrough (ResponseCache)
+}
+
+func (ew *etagWriter) WriteHeader(status int) {
+	if ew.passthrough {
+		ew.ResponseWriter.WriteHeader(status)
+		return
+	}
+	ew.status = status
+}
+
+func (ew *etagWriter) Write(b []byte) (int, error) {
+	if ew.passthrough {
+		return ew.ResponseWriter.Write(b)
+	}
+	if ew.buf.Len()+len(b) > maxETagBody {
+		ew.flushBuffer()
+		return ew.ResponseWriter.Write(b)
+	}
+	return ew.buf.Write(b)
+}
+
+// Flush - хендлер стримит: ETag уже не посчитать, отдаём как есть.
+func (ew *etagWriter) Flush() {
+	if !ew.passthrough {
+		ew.flushBuffer()
+	}
+	_ = http.NewResponseController(ew.ResponseWriter).Flush()
+}
+
+func (ew *etagWriter) Unwrap() http.ResponseWriter {
+	return ew.ResponseWriter
+}
+
+// flushBuffer отправляет накопленные статус и тело и включает passthrough.
+func (ew *etagWriter) flushBuffer() {
+	ew.passthrough = true
+	if ew.onPassthrough != nil {
+		ew.onPassthrough()
+	}
+	ew.ResponseWriter.WriteHeader(ew.status)
+	_, _ = ew.ResponseWriter.Write(ew.buf.Bytes())
+	ew.buf.Reset()
+}
@@ -0,0 +1,169 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestETag(t *testing.T) {
+	withTraceSources(t, TraceHeader("X-Request-ID"))
+	h := ETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		JSON(w, r, http.StatusOK, map[string]string{"name": "article"})
+	}))
+
+	first := get(h, "/", "X-Request-ID", "aaa")
+	etag := first.Header().Get("ETag")
+	// тело меняется вместе с trace_id, поэтому валидатор слабый
+	if first.Code != http.StatusOK || !strings.HasPrefix(etag, `W/"`) {
+		t.Fatalf("status = %d, ETag = %q", first.Code, etag)
+	}
+	if !strings.Contains(first.Body.String(), `"trace_id":"aaa"`) {
+		t.Fatalf("body = %s", first.Body.String())
+	}
+
+	// trace_id свой у каждого запроса, но на ETag не влияет
+	second := get(h, "/", "X-Request-ID", "bbb")
+	if got := second.Header().Get("ETag"); got != etag {
+		t.Errorf("ETag changed with trace_id: %q vs %q", got, etag)
+	}
+
+	for _, inm := range []string{etag, `"other", ` + etag, "*"} {
+		rec := get(h, "/", "If-None-Match", inm, "X-Request-ID", "ccc")
+		if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+			t.Errorf("If-None-Match %s: status %d body %q, want 304", inm, rec.Code, rec.Body.String())
+		}
+		if rec.Header().Get("Content-Type") != "" || rec.Header().Get("ETag") != etag {
+			t.Errorf("If-None-Match %s: headers %v", inm, rec.Header())
+		}
+	}
+
+	if rec := get(h, "/", "If-None-Match", `"other"`); rec.Code != http.StatusOK {
+		t.Errorf("mismatched If-None-Match: status %d, want 200", rec.Code)
+	}
+}
+
+func TestETagStrength(t *testing.T) {
+	withTraceSources(t, TraceHeader("X-Request-ID"))
+	plain := ETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"article"}`))
+	}))
+	if etag := get(plain, "/", "X-Request-ID", "aaa").Header().Get("ETag"); !strings.HasPrefix(etag, `"`) {
+		t.Errorf("byte-identical body: ETag = %q, want strong", etag)
+	}
+
+	// текст trace_id внутри data не вырезается: разные data - разные ETag
+	withData := func(data string) string {
+		h := ETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			JSON(w, r, http.StatusOK, map[string]string{"note": data})
+		}))
+		return get(h, "/", "X-Request-ID", "aaa").Header().Get("ETag")
+	}
+	if withData(`x,"trace_id":"aaa"`) == withData("x") {
+		t.Error("trace_id text inside data was stripped")
+	}
+}
+
+func TestCutTraceID(t *testing.T) {
+	tests := []struct {
+		body, id, want string
+		ok             bool
+	}{
+		{`{"success":true,"trace_id":"aaa"}` + "\n", "aaa", `{"success":true}` + "\n", true},
+		{`{"success":true,"trace_id":"aaa"}`, "aaa", `{"success":true}`, true},
+		{`{"success":true,"trace_id":"bbb"}`, "aaa", `{"success":true,"trace_id":"bbb"}`, false},
+		{`{"data":{"a":1,"trace_id":"aaa"}}`, "aaa", `{"data":{"a":1,"trace_id":"aaa"}}`, false},
+		{`{"data":",\"trace_id\":\"aaa\"","x":1}`, "aaa", `{"data":",\"trace_id\":\"aaa\"","x":1}`, false},
+		{`text,"trace_id":"aaa"}`, "aaa", `text,"trace_id":"aaa"}`, false},
+		{`{"success":true}`, "", `{"success":true}`, false},
+	}
+	for _, tt := range tests {
+		got, ok := cutTraceID([]byte(tt.body), tt.id)
+		if string(got) != tt.want || ok != tt.ok {
+			t.Errorf("cutTraceID(%s, %q) = %s, %v; want %s, %v", tt.body, tt.id, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestWeakETag(t *testing.T) {
+	h := WeakETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("body"))
+	}))
+	etag := get(h, "/").Header().Get("ETag")
+	if !strings.HasPrefix(etag, `W/"`) {
+		t.Fatalf("ETag = %q, want weak", etag)
+	}
+	if rec := get(h, "/", "If-None-Match", strings.TrimPrefix(etag, "W/")); rec.Code != http.StatusNotModified {
+		t.Errorf("strong form of weak ETag: status %d, want 304", rec.Code)
+	}
+}
+
+func TestETagSkips(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		handler http.HandlerFunc
+	}{
+		{"post", http.MethodPost, func(w http.ResponseWriter, r *http.Request) { _, _ = w.Write([]byte("x")) }},
+		{"error", http.MethodGet, func(w http.ResponseWriter, r *http.Request) { ErrorNotFound(w, r, "article") }},
+		{"large", http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(make([]byte, maxETagBody+1))
+		}},
+		{"flush", http.MethodGet, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("event"))
+			_ = http.NewResponseController(w).Flush()
+			_, _ = w.Write([]byte("more"))
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			ETag(tt.handler).ServeHTTP(rec, httptest.NewRequest(tt.method, "/", nil))
+			if etag := rec.Header().Get("ETag"); etag != "" {
+				t.Errorf("ETag = %q, want none", etag)
+			}
+			if tt.name == "flush" && (!rec.Flushed || rec.Body.String() != "eventmore") {
+				t.Errorf("flushed = %v body = %q", rec.Flushed, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestETagKeepsHandlerValidators(t *testing.T) {
+	modified := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := ETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v7"`)
+		w.Header().Set("Last-Modified", modified.Format(http.TimeFormat))
+		_, _ = w.Write([]byte("body"))
+	}))
+
+	if got := get(h, "/").Header().Get("ETag"); got != `"v7"` {
+		t.Errorf("ETag = %q, want handler value", got)
+	}
+	if rec := get(h, "/", "If-Modified-Since", modified.Format(http.TimeFormat)); rec.Code != http.StatusNotModified {
+		t.Errorf("If-Modified-Since: status %d, want 304", rec.Code)
+	}
+}
+
+func TestETagConcurrent(t *testing.T) {
+	h := ETag(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.URL.Query().Get("q")))
+	}))
+
+	var wg sync.WaitGroup
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q := strings.Repeat("x", i+1)
+			rec := get(h, "/?q="+q)
+			if rec.Body.String() != q || rec.Header().Get("ETag") != computeETag([]byte(q), "", false) {
+				t.Errorf("q=%s: body %q ETag %q", q, rec.Body.String(), rec.Header().Get("ETag"))
+			}
+		}()
+	}
+	wg.Wait()
+}
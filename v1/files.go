@@ -0,0 +1,267 @@
+package httpx
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+)
+
+const sniffLen = 3072 // столько байт mimetype читает для определения типа
+
+// File отдаёт файл с диска для показа в браузере (Content-Disposition: inline).
+//
+// Ставит Content-Type (по содержимому; text/plain и octet-stream уточняются
+// по расширению), Content-Length, Last-Modified; отвечает 304 на
+// If-None-Match / If-Modified-Since и поддерживает Range (см. ServeRange).
+//
+// Ошибки - Envelope: нет файла или это каталог → ErrorNotFound,
+// нет прав → ErrorForbidden, прочее → ErrorInternal.
+//
+// Status: 200 OK / 206 Partial Content / 304 Not Modified
+//
+// path не проверяется: имя из запроса отдавайте через FileFS.
+//
+// Пример:
+//
+//	httpx.File(w, r, "/srv/static/terms.pdf")
+func File(w http.ResponseWriter, r *http.Request, path string) {
+	serveFile(w, r, path, "inline", filepath.Base(path))
+}
+
+// FileFS - как File, но файл name берётся из fsys. Имя проверяется
+// fs.ValidPath ("../", абсолютные пути → ErrorNotFound), так что его можно
+// брать из запроса. С os.Root не выйти за каталог и по символическим ссылкам.
+//
+// Status: 200 OK / 206 Partial Content / 304 Not Modified
+//
+// Пример:
+//
+//	root, _ := os.OpenRoot(uploadsDir)
+//	httpx.FileFS(w, r, root.FS(), chi.URLParam(r, "name"))
+func FileFS(w http.ResponseWriter, r *http.Request, fsys fs.FS, name string) {
+	if !fs.ValidPath(name) {
+		ErrorNotFound(w, r, "file")
+		return
+	}
+	f, err := fsys.Open(name)
+	if err != nil {
+		fileError(w, r, err)
+		return
+	}
+	defer f.Close()
+	serveOpened(w, r, f, "inline", path.Base(name))
+}
+
+// Attachment - как File, но файл скачивается (Content-Disposition: attachment)
+// под именем filename (UTF-8 допустим; пустое - имя файла на диске).
+//
+// Status: 200 OK / 206 Partial Content / 304 Not Modified
+//
+// Пример:
+//
+//	httpx.Attachment(w, r, reportPath, "Отчёт за май.pdf")
+func Attachment(w http.ResponseWriter, r *http.Request, path, filename string) {
+	if filename == "" {
+		filename = filepath.Base(path)
+	}
+	serveFile(w, r, path, "attachment", filename)
+}
+
+// Stream отдаёт произвольный io.Reader как скачиваемый файл filename
+// (пустое имя - inline без имени). Если content - io.ReadSeeker, работают
+// Content-Length и Range; иначе тело уходит чанками. Last-Modified
+// ставится, если modtime не нулевой.
+//
+// Status: 200 OK / 206 Partial Content / 304 Not Modified
+//
+// Пример:
+//
+//	obj, _ := s3.GetObject(ctx, key)
+//	defer obj.Body.Close()
+//	httpx.Stream(w, r, obj.Body, "export.csv", obj.LastModified)
+func Stream(w http.ResponseWriter, r *http.Request, content io.Reader, filename string, modtime time.Time) {
+	disposition := "attachment"
+	if filename == "" {
+		disposition = "inline"
+	}
+	setFileHeaders(w, disposition, filename, modtime)
+
+	if notModified(r, w.Header().Get("ETag"), modtime) {
+		RedirectNotModified(w, r)
+		return
+	}
+
+	if rs, ok := content.(io.ReadSeeker); ok {
+		serveSeeker(w, r, rs, filename, modtime)
+		return
+	}
+
+	serveReader(w, r, content, filename)
+}
+
+// serveReader отдаёт не-seekable content чанками: тип определяем по началу,
+// которое затем отдаём первым.
+func serveReader(w http.ResponseWriter, r *http.Request, content io.Reader, filename string) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		readError(w, r)
+		return
+	}
+	head = head[:n]
+
+	w.Header().Set("Content-Type", contentType(head, filename))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		_, _ = io.Copy(w, io.MultiReader(bytes.NewReader(head), content))
+	}
+}
+
+func serveFile(w http.ResponseWriter, r *http.Request, path, disposition, filename string) {
+	f, err := os.Open(path)
+	if err != nil {
+		fileError(w, r, err)
+		return
+	}
+	defer f.Close()
+	serveOpened(w, r, f, disposition, filename)
+}
+
+// serveOpened отдаёт открытый файл; каталог - ErrorNotFound.
+func serveOpened(w http.ResponseWriter, r *http.Request, f fs.File, disposition, filename string) {
+	st, err := f.Stat()
+	if err != nil {
+		fileError(w, r, err)
+		return
+	}
+	if st.IsDir() {
+		ErrorNotFound(w, r, "file")
+		return
+	}
+
+	setFileHeaders(w, disposition, filename, st.ModTime())
+	if notModified(r, w.Header().Get("ETag"), st.ModTime()) {
+		RedirectNotModified(w, r)
+		return
+	}
+	if rs, ok := f.(io.ReadSeeker); ok {
+		serveSeeker(w, r, rs, filename, st.ModTime())
+		return
+	}
+	serveReader(w, r, f, filename)
+}
+
+// serveSeeker определяет Content-Type и отдаёт содержимое через ServeRange.
+func serveSeeker(w http.ResponseWriter, r *http.Request, rs io.ReadSeeker, filename string, modtime time.Time) {
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(rs, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		readError(w, r)
+		return
+	}
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		readError(w, r)
+		return
+	}
+	ServeRange(w, r, rs, contentType(head[:n], filename), modtime)
+}
+
+func setFileHeaders(w http.ResponseWriter, disposition, filename string, modtime time.Time) {
+	h := w.Header()
+	h.Set("Content-Disposition", contentDisposition(disposition, filename))
+	h.Set("X-Content-Type-Options", "nosniff")
+	if !modtime.IsZero() {
+		h.Set("Last-Modified", modtime.UTC().Format(http.TimeFormat))
+	}
+}
+
+// readError - 500 без файловых заголовков, выставленных до чтения.
+func readError(w http.ResponseWriter, r *http.Request) {
+	h := w.Header()
+	h.Del("Content-Disposition")
+	h.Del("Last-Modified")
+	ErrorInternal(w, r, "Cannot read content")
+}
+
+// fileError переводит ошибку файловой системы в Envelope-ответ.
+func fileError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		ErrorNotFound(w, r, "file")
+	case errors.Is(err, fs.ErrPermission):
+		ErrorForbidden(w, r, "Access to file denied")
+	default:
+		ErrorInternal(w, r, "Cannot read file")
+	}
+}
+
+// contentType - тип по содержимому (mimetype); общие text/plain и
+// application/octet-stream уточняются по расширению имени.
+func contentType(head []byte, filename string) string {
+	mt := mimetype.Detect(head)
+	if mt.Is("text/plain") || mt.Is("application/octet-stream") {
+		if byExt := mime.TypeByExtension(filepath.Ext(filename)); byExt != "" {
+			return byExt
+		}
+	}
+	return mt.String()
+}
+
+// contentDisposition собирает заголовок по RFC 6266: ASCII-имя в filename
+// и UTF-8 в filename* (RFC 8187), если имя не ASCII.
+func contentDisposition(disposition, filename string) string {
+	if filename == "" {
+		return disposition
+	}
+
+	var ascii strings.Builder
+	isASCII := true
+	for _, c := range filename {
+		switch {
+		case c < 0x20 || c == 0x7f:
+			ascii.WriteByte('_')
+		case c >= 0x80:
+			ascii.WriteByte('_')
+			isASCII = false
+		case c == '"' || c == '\\':
+			ascii.WriteByte('\\')
+			ascii.WriteRune(c)
+		default:
+			ascii.WriteRune(c)
+		}
+	}
+
+	v := disposition + `; filename="` + ascii.String() + `"`
+	if !isASCII {
+		v += "; filename*=UTF-8''" + encodeRFC8187(filename)
+	}
+	return v
+}
+
+// encodeRFC8187 - percent-encoding всего, кроме attr-char.
+func encodeRFC8187(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+			strings.IndexByte("!#$&+-.^_`|~", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hex[c>>4])
+		b.WriteByte(hex[c&15])
+	}
+	return b.String()
+}
@@ -0,0 +1,246 @@
+package httpx
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestFileFS(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "secret.txt"), []byte("secret"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	uploads := filepath.Join(dir, "uploads")
+	if err := os.MkdirAll(filepath.Join(uploads, "sub"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(uploads, "a.txt"), []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(dir, "secret.txt"), filepath.Join(uploads, "link.txt")); err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := os.OpenRoot(uploads)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = root.Close() })
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"a.txt", http.StatusOK, "hello"},
+		{"../secret.txt", http.StatusNotFound, ""},
+		{"/etc/passwd", http.StatusNotFound, ""},
+		{"sub", http.StatusNotFound, ""},
+		{"missing.txt", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			FileFS(rec, httptest.NewRequest(http.MethodGet, "/", nil), root.FS(), tt.name)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if tt.body != "" && rec.Body.String() != tt.body {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.body)
+			}
+		})
+	}
+
+	// символическая ссылка за пределы os.Root не открывается
+	rec := httptest.NewRecorder()
+	FileFS(rec, httptest.NewRequest(http.MethodGet, "/", nil), root.FS(), "link.txt")
+	if rec.Code == http.StatusOK {
+		t.Errorf("symlink escaped root: body %q", rec.Body.String())
+	}
+}
+
+func TestFileFSMemory(t *testing.T) {
+	fsys := fstest.MapFS{"doc.txt": {Data: []byte("plain text")}}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	FileFS(rec, r, fsys, "doc.txt")
+	if rec.Code != http.StatusOK || rec.Body.String() != "plain text" {
+		t.Fatalf("status = %d body %q", rec.Code, rec.Body.String())
+	}
+	if got := rec.Header().Get("Content-Disposition"); got != `inline; filename="doc.txt"` {
+		t.Errorf("Content-Disposition = %q", got)
+	}
+}
+
+func TestFileEmptyWithRange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.txt")
+	if err := os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Range", "bytes=0-")
+	rec := httptest.NewRecorder()
+	File(rec, r, path)
+
+	if rec.Code != http.StatusOK || rec.Body.Len() != 0 {
+		t.Fatalf("status = %d body %q, want 200 and empty body", rec.Code, rec.Body.String())
+	}
+	if got := rec.Header().Get("Content-Length"); got != "0" {
+		t.Errorf("Content-Length = %q, want 0", got)
+	}
+}
+
+func TestAttachmentDisposition(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	if err := os.WriteFile(path, []byte("%PDF-1.4\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		filename, want string
+	}{
+		{"", `attachment; filename="report.pdf"`},
+		{"May report.pdf", `attachment; filename="May report.pdf"`},
+		{`say "hi"\now.txt`, `attachment; filename="say \"hi\"\\now.txt"`},
+		{"line\nbreak\x7f.txt", `attachment; filename="line_break_.txt"`},
+		{"Отчёт за май.pdf", `attachment; filename="_____ __ ___.pdf"; filename*=UTF-8''%D0%9E%D1%82%D1%87%D1%91%D1%82%20%D0%B7%D0%B0%20%D0%BC%D0%B0%D0%B9.pdf`},
+		{`naïve "q".txt`, `attachment; filename="na_ve \"q\".txt"; filename*=UTF-8''na%C3%AFve%20%22q%22.txt`},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		Attachment(rec, httptest.NewRequest(http.MethodGet, "/", nil), path, tt.filename)
+		if got := rec.Header().Get("Content-Disposition"); got != tt.want {
+			t.Errorf("%q:\n got  %s\n want %s", tt.filename, got, tt.want)
+		}
+		if rec.Header().Get("Content-Type") != "application/pdf" {
+			t.Errorf("%q: Content-Type = %q", tt.filename, rec.Header().Get("Content-Type"))
+		}
+	}
+}
+
+func TestFileContentType(t *testing.T) {
+	dir := t.TempDir()
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	tests := []struct {
+		name    string
+		content []byte
+		want    string
+	}{
+		{"image.bin", png, "image/png"}, // по содержимому, расширение не важно
+		{"no-extension", png, "image/png"},
+		{"data.csv", []byte("a,b\n1,2\n"), "text/csv"}, // text/plain уточняется расширением
+		{"style.css", []byte("body{color:red}"), "text/css"},
+		{"notes", []byte("plain text"), "text/plain"},
+		{"blob.wasm", []byte{0, 1, 2, 3, 0xfe}, "application/wasm"}, // octet-stream уточняется расширением
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.name)
+		if err := os.WriteFile(path, tt.content, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		rec := httptest.NewRecorder()
+		File(rec, httptest.NewRequest(http.MethodGet, "/", nil), path)
+		if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, tt.want) {
+			t.Errorf("%s: Content-Type = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFileNotModified(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.txt")
+	if err := os.WriteFile(path, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := os.Chtimes(path, modified, modified); err != nil {
+		t.Fatal(err)
+	}
+
+	serve := func(ims string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if ims != "" {
+			r.Header.Set("If-Modified-Since", ims)
+		}
+		rec := httptest.NewRecorder()
+		File(rec, r, path)
+		return rec
+	}
+
+	rec := serve("")
+	if lm := rec.Header().Get("Last-Modified"); lm != modified.Format(http.TimeFormat) {
+		t.Fatalf("Last-Modified = %q", lm)
+	}
+	if rec := serve(modified.Format(http.TimeFormat)); rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("fresh If-Modified-Since: status %d body %q, want 304", rec.Code, rec.Body.String())
+	}
+	if rec := serve(modified.Add(-time.Hour).Format(http.TimeFormat)); rec.Code != http.StatusOK || rec.Body.String() != "hello" {
+		t.Errorf("stale If-Modified-Since: status %d body %q, want 200", rec.Code, rec.Body.String())
+	}
+}
+
+// onlyReader прячет Seek у reader (как у тела ответа S3).
+type onlyReader struct{ io.Reader }
+
+func TestStream(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	body := "id,name\n1,neo\n"
+
+	t.Run("seekable", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Range", "bytes=0-6")
+		rec := httptest.NewRecorder()
+		Stream(rec, r, strings.NewReader(body), "export.csv", modified)
+
+		if rec.Code != http.StatusPartialContent || rec.Body.String() != "id,name" {
+			t.Fatalf("status %d body %q, want 206 id,name", rec.Code, rec.Body.String())
+		}
+		h := rec.Header()
+		if h.Get("Content-Length") != "7" || h.Get("Accept-Ranges") != "bytes" ||
+			h.Get("Content-Disposition") != `attachment; filename="export.csv"` ||
+			h.Get("Last-Modified") != modified.Format(http.TimeFormat) {
+			t.Errorf("headers = %v", h)
+		}
+	})
+
+	t.Run("not seekable", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Range", "bytes=0-6") // без Seek диапазоны не поддерживаются
+		rec := httptest.NewRecorder()
+		Stream(rec, r, onlyReader{strings.NewReader(body)}, "export.csv", time.Time{})
+
+		if rec.Code != http.StatusOK || rec.Body.String() != body {
+			t.Fatalf("status %d body %q, want 200 and full body", rec.Code, rec.Body.String())
+		}
+		h := rec.Header()
+		if h.Get("Content-Length") != "" || h.Get("Last-Modified") != "" || !strings.HasPrefix(h.Get("Content-Type"), "text/csv") {
+			t.Errorf("headers = %v", h)
+		}
+	})
+
+	t.Run("not modified", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("If-Modified-Since", modified.Format(http.TimeFormat))
+		rec := httptest.NewRecorder()
+		Stream(rec, r, onlyReader{strings.NewReader(body)}, "export.csv", modified)
+		if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+			t.Errorf("status %d body %q, want 304", rec.Code, rec.Body.String())
+		}
+	})
+
+	t.Run("inline", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		Stream(rec, httptest.NewRequest(http.MethodGet, "/", nil), strings.NewReader(body), "", time.Time{})
+		if got := rec.Header().Get("Content-Disposition"); got != "inline" {
+			t.Errorf("Content-Disposition = %q, want inline", got)
+		}
+	})
+}
@@ -3,6 +3,7 @@ package httpx
 import (
 	"html"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 
@@ -10,36 +11,13 @@ import (
 	"github.com/go-playground/validator/v10"
 	"golang.org/x/text/language"
 
-	// locales
-	"github.com/go-playground/locales/de"
 	"github.com/go-playground/locales/en"
-	"github.com/go-playground/locales/es"
-	"github.com/go-playground/locales/fr"
-	"github.com/go-playground/locales/it"
-	"github.com/go-playground/locales/ja"
-	"github.com/go-playground/locales/ko"
-	"github.com/go-playground/locales/lv"
-	"github.com/go-playground/locales/pt"
-	"github.com/go-playground/locales/ru"
-	"github.com/go-playground/locales/zh"
-
-	// translations
-	de_trans "github.com/go-playground/validator/v10/translations/de"
 	en_trans "github.com/go-playground/validator/v10/translations/en"
-	es_trans "github.com/go-playground/validator/v10/translations/es"
-	fr_trans "github.com/go-playground/validator/v10/translations/fr"
-	it_trans "github.com/go-playground/validator/v10/translations/it"
-	ja_trans "github.com/go-playground/validator/v10/translations/ja"
-	ko_trans "github.com/go-playground/validator/v10/translations/ko"
-	pt_trans "github.com/go-playground/validator/v10/translations/pt"
-	ru_trans "github.com/go-playground/validator/v10/translations/ru"
-	zh_trans "github.com/go-playground/validator/v10/translations/zh"
 )
 
 var (
-	initOnce    sync.Once
-	V           *validator.Validate
-	translators map[string]ut.Translator
+	initOnce sync.Once
+	V        *validator.Validate
 )
 
 func init() {
@@ -53,42 +31,9 @@ func init() {
 			V = def
 		}
 
-		// Universal‑translator + регистрация языков
-		uni := ut.New(
-			en.New(),
-			en.New(),
-			ru.New(),
-			de.New(),
-			lv.New(),
-			zh.New(),
-			fr.New(),
-			es.New(),
-			it.New(),
-			pt.New(),
-			ja.New(),
-			ko.New(),
-		)
-
-		translators = make(map[string]ut.Translator, 12)
-
-		// helper
-		add := func(code string, reg func(*validator.Validate, ut.Translator) error) {
-			tr, _ := uni.GetTranslator(code)
-			_ = reg(V, tr)
-			translators[code] = tr
-		}
-
-		add("en", en_trans.RegisterDefaultTranslations)
-		add("ru", ru_trans.RegisterDefaultTranslations)
-		add("de", de_trans.RegisterDefaultTranslations)
-		add("zh", zh_trans.RegisterDefaultTranslations)
-		add("fr", fr_trans.RegisterDefaultTranslations)
-		add("es", es_trans.RegisterDefaultTranslations)
-		add("lv", en_trans.RegisterDefaultTranslations) // TODO: заменить, когда выйдет поддержка латышского языка
-		add("it", it_trans.RegisterDefaultTranslations)
-		add("pt", pt_trans.RegisterDefaultTranslations)
-		add("ja", ja_trans.RegisterDefaultTranslations)
-		add("ko", ko_trans.RegisterDefaultTranslations)
+		// Английский - fallback, доступен всегда (в т.ч. со сборкой httpx_minimal).
+		// Остальные языки регистрируются в locales_all.go и грузятся лениво.
+		RegisterLocale("en", en.New, en_trans.RegisterDefaultTranslations)
 	})
 }
 
@@ -104,24 +49,30 @@ func baseLocale(tag string) string { // helper
 //  1. X-Request-Lang
 //  2. Accept-Language
 //  3. fallback -> "en"
+//
+// Язык загружается при первом обращении (см. LoadLocales).
 func TranslatorFor(r *http.Request) ut.Translator {
 	if lang := baseLocale(r.Header.Get("X-Request-Lang")); lang != "" {
-		if tr, ok := translators[lang]; ok {
+		if tr, ok := translator(lang); ok {
 			return tr
 		}
 	}
 	if al := r.Header.Get("Accept-Language"); al != "" {
 		if tags, _, err := language.ParseAcceptLanguage(al); err == nil && len(tags) > 0 {
-			if tr, ok := translators[baseLocale(tags[0].String())]; ok {
+			if tr, ok := translator(baseLocale(tags[0].String())); ok {
 				return tr
 			}
 		}
 	}
-	return translators["en"]
+	tr, _ := translator(fallbackLocale)
+	return tr
 }
 
 // RegisterCustomValidator добавляет кастомное правило в валидатор + переводы.
 //
+// Переводы для ещё не загруженных языков запоминаются и применяются
+// при их загрузке.
+//
 // Пример:
 //
 //	httpx.RegisterCustomValidator("tz", validateTimeZone, map[string]string{
@@ -137,23 +88,60 @@ func RegisterCustomValidator(tag string, fn validator.Func, messages map[string]
 		return err
 	}
 
-	// Регистрируем переводы для всех подключённых Translator’ов
+	addMessages(tag, messages)
+	return nil
+}
+
+// RegisterContextValidator - как RegisterCustomValidator, но правило получает
+// контекст запроса из BindValidate: проверки в БД, внешние сервисы и т.п.
+//
+// Контекст отменяется вместе с запросом и ограничен ValidationTimeout;
+// правило должно его соблюдать. Если контекст истёк во время валидации,
+// BindValidate вернёт ошибку контекста, а не «поле невалидно».
+//
+// Пример:
+//
+//	httpx.RegisterContextValidator("unique_email", func(ctx context.Context, fl validator.FieldLevel) bool {
+//	    taken, err := users.EmailExists(ctx, fl.Field().String())
+//	    return err == nil && !taken
+//	}, map[string]string{
+//	    "en": "{0} is already registered",
+//	    "ru": "{0} уже зарегистрирован",
+//	})
+func RegisterContextValidator(tag string, fn validator.FuncCtx, messages map[string]string) error {
+	if V == nil {
+		return errValidatorUnset
+	}
+
+	if err := V.RegisterValidationCtx(tag, fn); err != nil {
+		return err
+	}
+
+	addMessages(tag, messages)
+	return nil
+}
+
+// addMessages запоминает переводы правила и регистрирует их
+// во всех уже загруженных Translator’ах.
+func addMessages(tag string, messages map[string]string) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	// Повторная регистрация тега заменяет его переводы, а не дублирует их.
+	i := slices.IndexFunc(customMessages, func(cm customMessage) bool { return cm.tag == tag })
+	if i >= 0 {
+		customMessages[i].messages = messages
+	} else {
+		customMessages = append(customMessages, customMessage{tag: tag, messages: messages})
+	}
+
+	// Регистрируем переводы для всех загруженных Translator’ов
 	for lang, msg := range messages {
 		tr, ok := translators[lang]
 		if !ok {
-			continue // язык не инициализирован - пропускаем
+			continue // язык ещё не загружен - переведём при загрузке
 		}
 
-		_ = V.RegisterTranslation(tag, tr,
-			func(ut ut.Translator) error {
-				return ut.Add(tag, msg, true)
-			},
-			func(ut ut.Translator, fe validator.FieldError) string {
-				t, _ := ut.T(tag)
-				return t
-			},
-		)
+		_ = registerMessage(V, tr, tag, msg)
 	}
-
-	return nil
 }
@@ -5,7 +5,7 @@ type Envelope struct {
 	Success bool        `json:"success"`            // true/false
 	Data    any         `json:"data,omitempty"`     // полезная нагрузка (если success)
 	Error   *ErrorBlock `json:"error,omitempty"`    // описание ошибки (если !success)
-	TraceID string      `json:"trace_id,omitempty"` // X-Request-ID, сквозной идентификатор
+	TraceID string      `json:"trace_id,omitempty"` // сквозной идентификатор (см. TraceIDSources)
 }
 
 // ErrorBlock - структура поля "error" в теле ответа.
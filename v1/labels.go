@@ -0,0 +1,209 @@
+package httpx
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	labelsMu    sync.RWMutex
+	fieldLabels = map[string]map[string]string{} // locale -> field -> label
+)
+
+// RegisterFieldLabels задаёт каталог локализованных названий полей,
+// которые подставляются в сообщения BindValidate вместо Go-имени поля.
+//
+// Ключ - "Struct.Field" (StructNamespace без индексов) или просто "Field".
+//
+//	httpx.RegisterFieldLabels("ru", map[string]string{
+//	    "Email":           "Почта",
+//	    "SignupDTO.Phone": "Телефон",
+//	})
+//
+// Для отдельных DTO удобнее struct-теги - они имеют приоритет над каталогом:
+//
+//	type SignupDTO struct {
+//	    Email string `json:"email" validate:"required,email" label:"E-mail" label_ru:"Почта"`
+//	}
+//
+// Порядок поиска: label_<locale> → каталог ("Struct.Field", затем "Field") → label.
+// Ключи details по-прежнему - имена полей; меняется только текст сообщения.
+func RegisterFieldLabels(locale string, labels map[string]string) {
+	labelsMu.Lock()
+	defer labelsMu.Unlock()
+
+	m, ok := fieldLabels[locale]
+	if !ok {
+		m = make(map[string]string, len(labels))
+		fieldLabels[locale] = m
+	}
+	for field, label := range labels {
+		m[field] = label
+	}
+}
+
+// fieldTranslator - переводчик языка, через который регистрируются
+// переводы в V. На время translateLabeled подменяет параметры {0} (имя
+// поля) и {1} (имя связанного поля, например у eqfield) их метками:
+// так метка попадает в шаблон, а готовый текст не правится.
+type fieldTranslator struct {
+	ut.Translator
+
+	mu                sync.Mutex // один перевод с метками за раз
+	field, label      string
+	param, paramLabel string
+}
+
+// T подставляет метки вместо {0} и {1}, если это имена переводимых полей.
+func (ft *fieldTranslator) T(key any, params ...string) (string, error) {
+	if ft.label != "" && len(params) > 0 && params[0] == ft.field {
+		params = slices.Clone(params)
+		params[0] = ft.label
+	}
+	if ft.paramLabel != "" && len(params) > 1 && params[1] == ft.param {
+		params = slices.Clone(params)
+		params[1] = ft.paramLabel
+	}
+	return ft.Translator.T(key, params...)
+}
+
+// translateLabeled переводит ошибку с метками полей в {0} и {1}.
+func translateLabeled(tr ut.Translator, typ reflect.Type, fe validator.FieldError) string {
+	ft, ok := tr.(*fieldTranslator)
+	if !ok {
+		return fe.Translate(tr)
+	}
+	label, _ := fieldLabel(typ, fe.StructNamespace(), fe.StructField(), tr.Locale())
+	if label == fe.Field() {
+		label = ""
+	}
+	paramLabel := paramName(typ, fe, tr.Locale())
+	if paramLabel == fe.Param() {
+		paramLabel = ""
+	}
+	if label == "" && paramLabel == "" {
+		return fe.Translate(tr)
+	}
+
+	ft.mu.Lock()
+	defer ft.mu.Unlock()
+	ft.field, ft.label, ft.param, ft.paramLabel = fe.Field(), label, fe.Param(), paramLabel
+	defer func() { ft.field, ft.label, ft.param, ft.paramLabel = "", "", "", "" }()
+	return fe.Translate(tr)
+}
+
+// paramName - метка (или имя для клиента, см. fieldAlias) поля, на которое
+// ссылается параметр правила (eqfield=Password, StructError.Param);
+// "" - параметр не имя соседнего поля.
+func paramName(typ reflect.Type, fe validator.FieldError, locale string) string {
+	param := fe.Param()
+	ns := stripIndexes(fe.StructNamespace())
+	i := strings.LastIndexByte(ns, '.')
+	if param == "" || i < 0 {
+		return ""
+	}
+	parent := elemType(typ)
+	if strings.Contains(ns[:i], ".") {
+		sf, ok := structField(typ, ns[:i])
+		if !ok {
+			return ""
+		}
+		parent = elemType(sf.Type)
+	}
+	if parent.Kind() != reflect.Struct {
+		return ""
+	}
+	if _, ok := parent.FieldByName(param); !ok {
+		return ""
+	}
+
+	if label, ok := fieldLabel(typ, ns[:i]+"."+param, param, locale); ok {
+		return label
+	}
+	return fieldAlias(parent, param)
+}
+
+// fieldLabel ищет метку поля по StructNamespace и Go-имени.
+func fieldLabel(typ reflect.Type, ns, field, locale string) (string, bool) {
+	sf, found := structField(typ, ns)
+	if found {
+		if label, ok := sf.Tag.Lookup("label_" + locale); ok {
+			return label, true
+		}
+	}
+
+	labelsMu.RLock()
+	m := fieldLabels[locale]
+	label, ok := m[stripIndexes(ns)]
+	if !ok {
+		label, ok = m[field]
+	}
+	labelsMu.RUnlock()
+	if ok {
+		return label, true
+	}
+
+	if found {
+		return sf.Tag.Lookup("label")
+	}
+	return "", false
+}
+
+// structField находит описание поля по StructNamespace ("DTO.Items[0].Name").
+func structField(typ reflect.Type, ns string) (reflect.StructField, bool) {
+	parts := strings.Split(stripIndexes(ns), ".")
+	if len(parts) < 2 {
+		return reflect.StructField{}, false
+	}
+
+	var sf reflect.StructField
+	for _, name := range parts[1:] { // parts[0] - имя корневой структуры
+		typ = elemType(typ)
+		if typ.Kind() != reflect.Struct {
+			return reflect.StructField{}, false
+		}
+		var ok bool
+		if sf, ok = typ.FieldByName(name); !ok {
+			return reflect.StructField{}, false
+		}
+		typ = sf.Type
+	}
+	return sf, true
+}
+
+// elemType раскрывает указатели и контейнеры до типа элемента.
+func elemType(typ reflect.Type) reflect.Type {
+	for {
+		switch typ.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			typ = typ.Elem()
+		default:
+			return typ
+		}
+	}
+}
+
+// stripIndexes убирает индексы коллекций: "DTO.Items[0].Name" -> "DTO.Items.Name".
+func stripIndexes(ns string) string {
+	if !strings.Contains(ns, "[") {
+		return ns
+	}
+	var b strings.Builder
+	depth := 0
+	for _, c := range ns {
+		switch {
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case depth == 0:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,89 @@
+package httpx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type labelItem struct {
+	Title string `validate:"required" label:"Title text" label_ru:"Заголовок"`
+}
+
+type labeledDTO struct {
+	Email string      `json:"email" validate:"required" label:"E-mail" label_ru:"Почта"`
+	Phone string      `json:"phone" validate:"required"`
+	City  string      `json:"city" validate:"required"`
+	Items []labelItem `validate:"dive"`
+}
+
+func TestFieldLabels(t *testing.T) {
+	if _, ok := translator("ru"); !ok {
+		t.Skip("ru locale is not built in (httpx_minimal)")
+	}
+	RegisterFieldLabels("ru", map[string]string{
+		"Phone":            "Телефон",
+		"labeledDTO.City":  "Город",
+		"City":             "не используется: ключ с типом точнее",
+		"labeledDTO.Email": "каталог уступает тегу label_ru",
+	})
+	t.Cleanup(func() {
+		labelsMu.Lock()
+		delete(fieldLabels, "ru")
+		labelsMu.Unlock()
+	})
+
+	dto := labeledDTO{Items: []labelItem{{}}}
+	for lang, want := range map[string]map[string]string{
+		"ru": {"Email": "Почта", "Phone": "Телефон", "City": "Город", "Title": "Заголовок"},
+		"en": {"Email": "E-mail", "Phone": "Phone", "City": "City", "Title": "Title text"},
+	} {
+		r := postJSON(context.Background(), "")
+		r.Header.Set("Accept-Language", lang)
+		details, err := validate(r, &dto)
+		if err == nil {
+			t.Fatalf("%s: no validation error", lang)
+		}
+		for field, label := range want {
+			msg := details[field]
+			if len(msg) < len(label) || msg[:len(label)] != label {
+				t.Errorf("%s: %s = %q, want it to start with %q", lang, field, msg, label)
+			}
+		}
+	}
+}
+
+type labelOrderDTO struct {
+	Value string `validate:"labelorder" label:"Amount"`
+}
+
+func TestFieldLabelIsParam(t *testing.T) {
+	// имя поля встречается в тексте шаблона раньше {0}: метка должна
+	// попасть именно в {0}, а не в первое вхождение "Value"
+	err := RegisterCustomValidator("labelorder", func(validator.FieldLevel) bool { return false }, map[string]string{
+		"en": "Value of {0} is out of range",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := postJSON(context.Background(), "")
+	r.Header.Set("Accept-Language", "en")
+	details, _ := validate(r, &labelOrderDTO{})
+	if got, want := details["Value"], "Value of Amount is out of range"; got != want {
+		t.Errorf("message = %q, want %q", got, want)
+	}
+}
+
+func TestStripIndexes(t *testing.T) {
+	for in, want := range map[string]string{
+		"DTO.Name":                 "DTO.Name",
+		"DTO.Items[0].Name":        "DTO.Items.Name",
+		"DTO.Map[key[1]].Tags[12]": "DTO.Map.Tags",
+	} {
+		if got := stripIndexes(in); got != want {
+			t.Errorf("stripIndexes(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
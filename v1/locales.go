@@ -0,0 +1,154 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/go-playground/locales"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// errUnknownLocale - язык не зарегистрирован или отключён через UseLocales.
+var errUnknownLocale = errors.New("httpx: unknown or disabled locale")
+
+// fallbackLocale - язык по умолчанию; его нельзя отключить.
+const fallbackLocale = "en"
+
+// localeSpec - рецепт языка: конструктор locales + регистрация переводов validator.
+type localeSpec struct {
+	newLocale func() locales.Translator
+	register  func(*validator.Validate, ut.Translator) error
+}
+
+// customMessage - переводы из RegisterCustomValidator, которые нужно
+// применить к языкам, загруженным позже.
+type customMessage struct {
+	tag      string
+	messages map[string]string
+}
+
+var (
+	localesMu      sync.RWMutex
+	localeSpecs    = map[string]localeSpec{}
+	enabledLocales map[string]bool // nil - разрешены все зарегистрированные
+	translators    = map[string]ut.Translator{}
+	customMessages []customMessage
+)
+
+// RegisterLocale добавляет язык (или заменяет встроенный). Сам язык
+// загружается лениво - при первом запросе на нём или через LoadLocales.
+//
+// Пригодится со сборкой `-tags httpx_minimal`, когда в бинарник попадает
+// только английский, а нужные языки подключаются явно:
+//
+//	httpx.RegisterLocale("ru", ru.New, ru_trans.RegisterDefaultTranslations)
+func RegisterLocale(code string, newLocale func() locales.Translator, register func(*validator.Validate, ut.Translator) error) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	localeSpecs[code] = localeSpec{newLocale: newLocale, register: register}
+	delete(translators, code) // перезагрузить при следующем обращении
+}
+
+// UseLocales ограничивает набор языков, которые httpx будет загружать.
+// Английский (fallback) разрешён всегда. Без аргументов снимает ограничение.
+//
+// Вызывайте при старте сервиса, до обработки запросов:
+//
+//	httpx.UseLocales("ru", "lv")
+func UseLocales(codes ...string) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	if len(codes) == 0 {
+		enabledLocales = nil
+		return
+	}
+
+	enabledLocales = make(map[string]bool, len(codes)+1)
+	enabledLocales[fallbackLocale] = true
+	for _, code := range codes {
+		enabledLocales[code] = true
+	}
+
+	for code := range translators {
+		if !enabledLocales[code] {
+			delete(translators, code)
+		}
+	}
+}
+
+// LoadLocales загружает языки сразу, не дожидаясь первого запроса
+// (прогрев при старте). Без аргументов - все разрешённые языки.
+func LoadLocales(codes ...string) error {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	if len(codes) == 0 {
+		for code := range localeSpecs {
+			if enabledLocales == nil || enabledLocales[code] {
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	for _, code := range codes {
+		if _, ok := loadLocale(code); !ok {
+			return fmt.Errorf("%w: %q", errUnknownLocale, code)
+		}
+	}
+	return nil
+}
+
+// translator возвращает переводчик языка, загружая его при необходимости.
+func translator(code string) (ut.Translator, bool) {
+	localesMu.RLock()
+	tr, ok := translators[code]
+	_, known := localeSpecs[code]
+	known = known && (enabledLocales == nil || enabledLocales[code])
+	localesMu.RUnlock()
+	if ok {
+		return tr, true
+	}
+	if !known { // неизвестный язык (Accept-Language: sv) - без эксклюзивной блокировки
+		return nil, false
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	return loadLocale(code)
+}
+
+// loadLocale строит переводчик и регистрирует переводы в V.
+// Вызывать под localesMu.Lock.
+func loadLocale(code string) (ut.Translator, bool) {
+	if tr, ok := translators[code]; ok {
+		return tr, true
+	}
+
+	spec, ok := localeSpecs[code]
+	if !ok || (enabledLocales != nil && !enabledLocales[code]) {
+		return nil, false
+	}
+
+	loc := spec.newLocale()
+	base, _ := ut.New(loc, loc).GetTranslator(loc.Locale())
+	tr := &fieldTranslator{Translator: base} // метки полей, см. translateLabeled
+
+	if V != nil {
+		_ = spec.register(V, tr)
+		if msg, ok := nohtmlMessages[code]; ok {
+			_ = registerMessage(V, tr, "nohtml", msg)
+		}
+		for _, cm := range customMessages {
+			if msg, ok := cm.messages[code]; ok {
+				_ = registerMessage(V, tr, cm.tag, msg)
+			}
+		}
+	}
+
+	translators[code] = tr
+	return tr, true
+}
@@ -0,0 +1,55 @@
+//go:build !httpx_minimal
+
+package httpx
+
+import (
+	"github.com/go-playground/locales/ar"
+	"github.com/go-playground/locales/de"
+	"github.com/go-playground/locales/es"
+	"github.com/go-playground/locales/fr"
+	"github.com/go-playground/locales/he"
+	"github.com/go-playground/locales/it"
+	"github.com/go-playground/locales/ja"
+	"github.com/go-playground/locales/ko"
+	"github.com/go-playground/locales/lv"
+	"github.com/go-playground/locales/nl"
+	"github.com/go-playground/locales/pl"
+	"github.com/go-playground/locales/pt"
+	"github.com/go-playground/locales/ru"
+	"github.com/go-playground/locales/tr"
+	"github.com/go-playground/locales/uk"
+	"github.com/go-playground/locales/zh"
+
+	de_trans "github.com/go-playground/validator/v10/translations/de"
+	es_trans "github.com/go-playground/validator/v10/translations/es"
+	fr_trans "github.com/go-playground/validator/v10/translations/fr"
+	it_trans "github.com/go-playground/validator/v10/translations/it"
+	ja_trans "github.com/go-playground/validator/v10/translations/ja"
+	ko_trans "github.com/go-playground/validator/v10/translations/ko"
+	pt_trans "github.com/go-playground/validator/v10/translations/pt"
+	ru_trans "github.com/go-playground/validator/v10/translations/ru"
+	zh_trans "github.com/go-playground/validator/v10/translations/zh"
+)
+
+// Полный набор языков. Сборка с `-tags httpx_minimal` исключает этот файл
+// вместе с таблицами translations_*.go - остаётся только английский.
+func init() {
+	RegisterLocale("ru", ru.New, ru_trans.RegisterDefaultTranslations)
+	RegisterLocale("de", de.New, de_trans.RegisterDefaultTranslations)
+	RegisterLocale("zh", zh.New, zh_trans.RegisterDefaultTranslations)
+	RegisterLocale("fr", fr.New, fr_trans.RegisterDefaultTranslations)
+	RegisterLocale("es", es.New, es_trans.RegisterDefaultTranslations)
+	RegisterLocale("it", it.New, it_trans.RegisterDefaultTranslations)
+	RegisterLocale("pt", pt.New, pt_trans.RegisterDefaultTranslations)
+	RegisterLocale("ja", ja.New, ja_trans.RegisterDefaultTranslations)
+	RegisterLocale("ko", ko.New, ko_trans.RegisterDefaultTranslations)
+
+	// таблицы httpx (translations_*.go)
+	RegisterLocale("lv", lv.New, lvMessages.register)
+	RegisterLocale("uk", uk.New, ukMessages.register)
+	RegisterLocale("pl", pl.New, plMessages.register)
+	RegisterLocale("tr", tr.New, trMessages.register)
+	RegisterLocale("ar", ar.New, arMessages.register)
+	RegisterLocale("he", he.New, heMessages.register)
+	RegisterLocale("nl", nl.New, nlMessages.register)
+}
@@ -0,0 +1,83 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// Стоимость старта: сколько времени и памяти уходит на загрузку языков в
+// свежий валидатор. Сравнение английского с полным набором:
+//
+//	go test -run '^$' -bench LoadLocales -benchmem .
+//	go test -run '^$' -bench LoadLocales -benchmem -tags httpx_minimal .
+//
+// В сборке httpx_minimal BenchmarkLoadLocalesAll грузит только английский.
+
+// resetLocales - чистые V и кеш переводчиков на время бенчмарка.
+func resetLocales(b testing.TB) {
+	b.Helper()
+	localesMu.Lock()
+	prevV, prevTr := V, translators
+	localesMu.Unlock()
+	b.Cleanup(func() {
+		localesMu.Lock()
+		V, translators = prevV, prevTr
+		localesMu.Unlock()
+	})
+}
+
+func benchmarkLoadLocales(b *testing.B, codes ...string) {
+	resetLocales(b)
+	b.ReportAllocs()
+
+	for b.Loop() {
+		localesMu.Lock()
+		V = validator.New()
+		translators = map[string]ut.Translator{}
+		localesMu.Unlock()
+
+		if err := LoadLocales(codes...); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(len(translators)), "locales")
+}
+
+func BenchmarkLoadLocalesEnglish(b *testing.B) {
+	benchmarkLoadLocales(b, fallbackLocale)
+}
+
+func BenchmarkLoadLocalesAll(b *testing.B) {
+	benchmarkLoadLocales(b)
+}
+
+// Неизвестный язык не должен брать эксклюзивную блокировку на каждом запросе.
+func BenchmarkTranslatorForUnknownLocale(b *testing.B) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Language", "sv")
+	b.ReportAllocs()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if TranslatorFor(r) == nil {
+				b.Fatal("nil translator")
+			}
+		}
+	})
+}
+
+func TestTranslatorUnknownLocale(t *testing.T) {
+	if tr, ok := translator("sv"); ok || tr != nil {
+		t.Fatalf("translator(sv) = %v, %v; want nil, false", tr, ok)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Language", "sv, ru;q=0.5")
+	if got := TranslatorFor(r).Locale(); got != fallbackLocale {
+		t.Fatalf("TranslatorFor(sv) locale = %s, want %s", got, fallbackLocale)
+	}
+}
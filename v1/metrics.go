@@ -0,0 +1,131 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/go-playground/validator/v10"
+)
+
+// MetricsSink - приёмник метрик httpx. Готовая реализация в памяти
+// процесса с выводом в формате Prometheus - Registry.
+type MetricsSink interface {
+	// ObserveResponse - ответ, прошедший через Instrument: шаблон роута chi,
+	// статус, ErrorBlock.Code ("" для успешных), размер тела и длительность.
+	ObserveResponse(route string, status int, code string, size int64, duration time.Duration)
+
+	// BindFailure - ошибка BindValidate / BindStream для типа dto:
+	// rule - тег правила ("required", "email", …) или "decode" для невалидного JSON.
+	BindFailure(dto, rule string)
+}
+
+// Metrics - текущий приёмник; nil - метрики не собираются.
+//
+// Пример:
+//
+//	reg := httpx.NewRegistry()
+//	httpx.Metrics = reg
+//	r.Use(httpx.Instrument)
+//	r.Handle("/metrics", reg)
+var Metrics MetricsSink
+
+type metricsCtxKey struct{}
+
+// responseMeta - то, что ответ сообщает Instrument из глубины хендлера.
+type responseMeta struct {
+	code string
+}
+
+// Instrument - middleware: передаёт в Metrics статус, код ошибки, размер
+// и длительность каждого ответа. Роут - шаблон chi ("/users/{id}"),
+// для несовпавших запросов - "unmatched".
+func Instrument(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sink := Metrics
+		if sink == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		meta := &responseMeta{}
+		mw := &metricsWriter{ResponseWriter: w}
+		r = r.WithContext(context.WithValue(r.Context(), metricsCtxKey{}, meta))
+		next.ServeHTTP(mw, r)
+
+		route := "unmatched"
+		if rc := chi.RouteContext(r.Context()); rc != nil && rc.RoutePattern() != "" {
+			route = rc.RoutePattern()
+		}
+		status := mw.status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		sink.ObserveResponse(route, status, meta.code, mw.size, time.Since(start))
+	})
+}
+
+// recordErrorCode запоминает код ошибки для Instrument (вызывается из Error).
+func recordErrorCode(r *http.Request, code string) {
+	if meta, ok := r.Context().Value(metricsCtxKey{}).(*responseMeta); ok {
+		meta.code = code
+	}
+}
+
+// recordBindFailures - по одной ошибке на каждое непройденное правило.
+func recordBindFailures(dst any, ve validator.ValidationErrors) {
+	if sink := Metrics; sink != nil {
+		dto := dtoName(reflect.TypeOf(dst))
+		for _, fe := range ve {
+			sink.BindFailure(dto, fe.Tag())
+		}
+	}
+}
+
+// recordDecodeFailure - тело dst не разобралось как JSON.
+func recordDecodeFailure(dst any) {
+	if sink := Metrics; sink != nil {
+		sink.BindFailure(dtoName(reflect.TypeOf(dst)), "decode")
+	}
+}
+
+func dtoName(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+// metricsWriter считает статус и размер тела.
+type metricsWriter struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+func (mw *metricsWriter) WriteHeader(status int) {
+	if mw.status == 0 && status >= http.StatusOK {
+		mw.status = status
+	}
+	mw.ResponseWriter.WriteHeader(status)
+}
+
+func (mw *metricsWriter) Write(b []byte) (int, error) {
+	if mw.status == 0 {
+		mw.status = http.StatusOK
+	}
+	n, err := mw.ResponseWriter.Write(b)
+	mw.size += int64(n)
+	return n, err
+}
+
+func (mw *metricsWriter) Flush() {
+	_ = http.NewResponseController(mw.ResponseWriter).Flush()
+}
+
+func (mw *metricsWriter) Unwrap() http.ResponseWriter {
+	return mw.ResponseWriter
+}
@@ -0,0 +1,156 @@
+package httpx
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	durationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+	sizeBuckets     = []float64{100, 1 << 10, 10 << 10, 100 << 10, 1 << 20, 10 << 20}
+)
+
+// Registry - MetricsSink в памяти процесса. Отдаёт метрики в текстовом
+// формате Prometheus (WritePrometheus или как http.Handler):
+//
+//	httpx_responses_total{route,status,code}                  - counter
+//	httpx_response_duration_seconds{route,status_class}       - histogram
+//	httpx_response_size_bytes{route,status_class}             - histogram
+//	httpx_bind_failures_total{dto,rule}                       - counter
+//
+// status_class - класс статуса ("2xx", "4xx", "5xx"): быстрые 404 не
+// смешиваются с задержками успешных ответов.
+type Registry struct {
+	mu           sync.Mutex
+	responses    map[[3]string]uint64     // route, status, code
+	durations    map[[2]string]*histogram // route, status_class
+	sizes        map[[2]string]*histogram
+	bindFailures map[[2]string]uint64 // dto, rule
+}
+
+// NewRegistry создаёт пустой реестр.
+func NewRegistry() *Registry {
+	return &Registry{
+		responses:    map[[3]string]uint64{},
+		durations:    map[[2]string]*histogram{},
+		sizes:        map[[2]string]*histogram{},
+		bindFailures: map[[2]string]uint64{},
+	}
+}
+
+// ObserveResponse реализует MetricsSink.
+func (reg *Registry) ObserveResponse(route string, status int, code string, size int64, duration time.Duration) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.responses[[3]string{route, strconv.Itoa(status), code}]++
+	series := [2]string{route, strconv.Itoa(status/100) + "xx"}
+	observe(reg.durations, series, durationBuckets, duration.Seconds())
+	observe(reg.sizes, series, sizeBuckets, float64(size))
+}
+
+// BindFailure реализует MetricsSink.
+func (reg *Registry) BindFailure(dto, rule string) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.bindFailures[[2]string{dto, rule}]++
+}
+
+// ServeHTTP отдаёт метрики для Prometheus (роут /metrics).
+func (reg *Registry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_ = reg.WritePrometheus(w)
+}
+
+// WritePrometheus пишет все метрики в текстовом формате Prometheus 0.0.4;
+// серии отсортированы по меткам.
+func (reg *Registry) WritePrometheus(w io.Writer) error {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprint(bw, "# HELP httpx_responses_total Responses by route, status and error code.\n")
+	fmt.Fprint(bw, "# TYPE httpx_responses_total counter\n")
+	for _, k := range sortedKeys(reg.responses) {
+		fmt.Fprintf(bw, "httpx_responses_total{route=%s,status=%s,code=%s} %d\n",
+			label(k[0]), label(k[1]), label(k[2]), reg.responses[k])
+	}
+
+	writeHistograms(bw, "httpx_response_duration_seconds", "Response latency by route and status class.", reg.durations)
+	writeHistograms(bw, "httpx_response_size_bytes", "Response body size by route and status class.", reg.sizes)
+
+	fmt.Fprint(bw, "# HELP httpx_bind_failures_total Bind and validation failures by DTO and rule.\n")
+	fmt.Fprint(bw, "# TYPE httpx_bind_failures_total counter\n")
+	for _, k := range sortedKeys(reg.bindFailures) {
+		fmt.Fprintf(bw, "httpx_bind_failures_total{dto=%s,rule=%s} %d\n", label(k[0]), label(k[1]), reg.bindFailures[k])
+	}
+
+	return bw.Flush()
+}
+
+// histogram - накопительные счётчики по верхним границам bounds.
+type histogram struct {
+	bounds []float64
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+func observe(m map[[2]string]*histogram, series [2]string, bounds []float64, v float64) {
+	h, ok := m[series]
+	if !ok {
+		h = &histogram{bounds: bounds, counts: make([]uint64, len(bounds))}
+		m[series] = h
+	}
+	for i, b := range h.bounds {
+		if v <= b {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+func writeHistograms(w io.Writer, name, help string, m map[[2]string]*histogram) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", name, help, name)
+	for _, k := range sortedKeys(m) {
+		h, l := m[k], "route="+label(k[0])+",status_class="+label(k[1])
+		for i, b := range h.bounds {
+			fmt.Fprintf(w, "%s_bucket{%s,le=\"%s\"} %d\n", name, l, formatFloat(b), h.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket{%s,le=\"+Inf\"} %d\n", name, l, h.count)
+		fmt.Fprintf(w, "%s_sum{%s} %s\n", name, l, formatFloat(h.sum))
+		fmt.Fprintf(w, "%s_count{%s} %d\n", name, l, h.count)
+	}
+}
+
+// label - значение метки в кавычках с экранированием \, " и перевода строки.
+func label(v string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v) + `"`
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// sortedKeys - ключи m по возрастанию меток (поле за полем).
+func sortedKeys[K [2]string | [3]string, V any](m map[K]V) []K {
+	keys := slices.Collect(maps.Keys(m))
+	slices.SortFunc(keys, func(a, b K) int {
+		for i := range len(a) {
+			if c := strings.Compare(a[i], b[i]); c != 0 {
+				return c
+			}
+		}
+		return 0
+	})
+	return keys
+}
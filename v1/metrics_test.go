@@ -0,0 +1,193 @@
+package httpx
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+)
+
+// withMetrics подменяет Metrics на время теста.
+func withMetrics(t *testing.T, sink MetricsSink) {
+	t.Helper()
+	prev := Metrics
+	Metrics = sink
+	t.Cleanup(func() { Metrics = prev })
+}
+
+func TestRegistryWritePrometheus(t *testing.T) {
+	reg := NewRegistry()
+	reg.ObserveResponse("/users/{id}", 200, "", 50, 30*time.Millisecond)
+	reg.ObserveResponse("/users/{id}", 200, "", 2000, 300*time.Millisecond)
+	reg.ObserveResponse("/users/{id}", 404, "NOT_FOUND", 80, 3*time.Millisecond)
+	reg.ObserveResponse(`/q"x`, 200, "", 0, 0)
+	reg.BindFailure("main.SignupDTO", "email")
+	reg.BindFailure("main.SignupDTO", "email")
+
+	var buf bytes.Buffer
+	if err := reg.WritePrometheus(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+
+	for _, line := range []string{
+		"# TYPE httpx_responses_total counter",
+		`httpx_responses_total{route="/users/{id}",status="200",code=""} 2`,
+		`httpx_responses_total{route="/users/{id}",status="404",code="NOT_FOUND"} 1`,
+		`httpx_responses_total{route="/q\"x",status="200",code=""} 1`,
+		"# TYPE httpx_response_duration_seconds histogram",
+		// накопительные бакеты по классам статуса: 2xx - 30ms ≤ .05, 300ms ≤ .5;
+		// 4xx - 3ms ≤ .005
+		`httpx_response_duration_seconds_bucket{route="/users/{id}",status_class="2xx",le="0.005"} 0`,
+		`httpx_response_duration_seconds_bucket{route="/users/{id}",status_class="2xx",le="0.05"} 1`,
+		`httpx_response_duration_seconds_bucket{route="/users/{id}",status_class="2xx",le="0.25"} 1`,
+		`httpx_response_duration_seconds_bucket{route="/users/{id}",status_class="2xx",le="0.5"} 2`,
+		`httpx_response_duration_seconds_bucket{route="/users/{id}",status_class="2xx",le="+Inf"} 2`,
+		`httpx_response_duration_seconds_count{route="/users/{id}",status_class="2xx"} 2`,
+		`httpx_response_duration_seconds_bucket{route="/users/{id}",status_class="4xx",le="0.005"} 1`,
+		`httpx_response_duration_seconds_count{route="/users/{id}",status_class="4xx"} 1`,
+		`httpx_response_size_bytes_bucket{route="/users/{id}",status_class="2xx",le="100"} 1`,
+		`httpx_response_size_bytes_bucket{route="/users/{id}",status_class="2xx",le="1024"} 1`,
+		`httpx_response_size_bytes_bucket{route="/users/{id}",status_class="2xx",le="10240"} 2`,
+		`httpx_response_size_bytes_sum{route="/users/{id}",status_class="2xx"} 2050`,
+		`httpx_response_size_bytes_sum{route="/users/{id}",status_class="4xx"} 80`,
+		`httpx_bind_failures_total{dto="main.SignupDTO",rule="email"} 2`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("missing line %q", line)
+		}
+	}
+
+	// серии отсортированы поле за полем: 200 перед 404, 2xx перед 4xx,
+	// "/q\"x" перед "/users/{id}"
+	for _, pair := range [][2]string{
+		{`status="200",code=""} 2`, `status="404"`},
+		{`status_class="2xx",le="0.005"}`, `status_class="4xx",le="0.005"}`},
+		{`route="/q\"x",status_class`, `route="/users/{id}",status_class`},
+	} {
+		if strings.Index(out, pair[0]) > strings.Index(out, pair[1]) {
+			t.Errorf("series are not sorted: %s after %s", pair[0], pair[1])
+		}
+	}
+}
+
+func TestRegistryServeHTTP(t *testing.T) {
+	reg := NewRegistry()
+	rec := httptest.NewRecorder()
+	reg.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if !strings.Contains(rec.Body.String(), "# TYPE httpx_bind_failures_total counter") {
+		t.Errorf("body = %q", rec.Body.String())
+	}
+}
+
+// observation - один вызов ObserveResponse.
+type observation struct {
+	route  string
+	status int
+	code   string
+	size   int64
+}
+
+type recordingSink struct {
+	mu  sync.Mutex
+	obs []observation
+}
+
+func (s *recordingSink) ObserveResponse(route string, status int, code string, size int64, _ time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.obs = append(s.obs, observation{route, status, code, size})
+}
+
+func (s *recordingSink) BindFailure(string, string) {}
+
+func TestInstrument(t *testing.T) {
+	sink := &recordingSink{}
+	withMetrics(t, sink)
+
+	r := chi.NewRouter()
+	r.Use(Instrument)
+	r.Get("/users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if chi.URLParam(r, "id") == "0" {
+			ErrorNotFound(w, r, "user")
+			return
+		}
+		_, _ = w.Write([]byte("hello"))
+	})
+	r.Route("/api", func(r chi.Router) {
+		r.Post("/items", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+	})
+
+	for _, target := range []string{"GET /users/1", "GET /users/0", "POST /api/items", "GET /nowhere"} {
+		method, path, _ := strings.Cut(target, " ")
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, path, nil))
+	}
+
+	want := []observation{
+		{"/users/{id}", 200, "", 5},
+		{"/users/{id}", 404, ErrorCode(http.StatusNotFound), -1},
+		{"/api/items", 204, "", 0},
+		{"unmatched", 404, "", -1},
+	}
+	if len(sink.obs) != len(want) {
+		t.Fatalf("observations = %+v, want %d", sink.obs, len(want))
+	}
+	for i, w := range want {
+		got := sink.obs[i]
+		if w.size < 0 { // размер тела ошибки не сверяем, но он не нулевой
+			w.size = got.size
+			if got.size == 0 {
+				t.Errorf("%d: size = 0", i)
+			}
+		}
+		if got != w {
+			t.Errorf("%d: got %+v, want %+v", i, got, w)
+		}
+	}
+}
+
+func TestInstrumentDisabled(t *testing.T) {
+	withMetrics(t, nil)
+
+	var wrapped bool
+	h := Instrument(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, wrapped = w.(*metricsWriter)
+	}))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if wrapped {
+		t.Error("writer wrapped with Metrics == nil")
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	reg := NewRegistry()
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				reg.ObserveResponse("/", 200, "", 1, time.Millisecond)
+				reg.BindFailure("dto", "required")
+			}
+		}()
+	}
+	wg.Wait()
+
+	var buf bytes.Buffer
+	_ = reg.WritePrometheus(&buf)
+	if !strings.Contains(buf.String(), `httpx_responses_total{route="/",status="200",code=""} 800`) {
+		t.Errorf("lost updates:\n%s", buf.String())
+	}
+}
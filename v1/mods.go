@@ -0,0 +1,238 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// errUnknownTransformer - в теге mod указан незарегистрированный трансформер.
+var errUnknownTransformer = errors.New("httpx: unknown mod transformer")
+
+// Transformer нормализует строковое значение поля (см. тег mod).
+type Transformer func(s string) string
+
+var (
+	transformersMu sync.RWMutex
+	transformers   = map[string]Transformer{
+		"trim":      strings.TrimSpace,
+		"ltrim":     func(s string) string { return strings.TrimLeftFunc(s, unicode.IsSpace) },
+		"rtrim":     func(s string) string { return strings.TrimRightFunc(s, unicode.IsSpace) },
+		"lower":     strings.ToLower,
+		"upper":     strings.ToUpper,
+		"collapse":  func(s string) string { return strings.Join(strings.Fields(s), " ") },
+		"nozw":      stripZeroWidth,
+		"striphtml": stripHTML,
+		"escape":    html.EscapeString,
+	}
+)
+
+// RegisterTransformer добавляет (или заменяет) трансформер для тега mod.
+//
+// Встроенные: trim, ltrim, rtrim, lower, upper, collapse (схлопывает пробелы
+// и обрезает края), nozw (убирает zero-width символы), striphtml (вырезает
+// теги, script/style и раскрывает сущности), escape (html.EscapeString).
+//
+// Пример:
+//
+//	httpx.RegisterTransformer("digits", func(s string) string {
+//	    return strings.Map(func(r rune) rune {
+//	        if unicode.IsDigit(r) {
+//	            return r
+//	        }
+//	        return -1
+//	    }, s)
+//	})
+func RegisterTransformer(name string, fn Transformer) {
+	transformersMu.Lock()
+	defer transformersMu.Unlock()
+	transformers[name] = fn
+}
+
+// applyMods применяет теги mod:"trim,lower" к строковым полям v
+// (string, *string, []string, map[...]string) слева направо. Вложенные
+// структуры, указатели на них, слайсы и map обходятся рекурсивно.
+//
+// BindValidate вызывает её между декодированием и валидацией.
+func applyMods(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			return applyMods(v.Elem())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+
+			if tag, ok := sf.Tag.Lookup("mod"); ok {
+				fns, err := lookupTransformers(tag)
+				if err != nil {
+					return fmt.Errorf("%w: %s.%s", err, t.Name(), sf.Name)
+				}
+				modify(v.Field(i), fns)
+				continue
+			}
+			if err := applyMods(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			if err := applyMods(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.Pointer {
+			return nil // элементы map неадресуемы - меняем только через указатели
+		}
+		for it := v.MapRange(); it.Next(); {
+			if err := applyMods(it.Value()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// modify применяет fns к строкам поля: string, *string, []string, map[K]string.
+func modify(v reflect.Value, fns []Transformer) {
+	switch v.Kind() {
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(transform(v.String(), fns))
+		}
+	case reflect.Pointer:
+		if !v.IsNil() {
+			modify(v.Elem(), fns)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			modify(v.Index(i), fns)
+		}
+	case reflect.Map:
+		if v.Type().Elem().Kind() != reflect.String {
+			return
+		}
+		for it := v.MapRange(); it.Next(); {
+			s := reflect.New(v.Type().Elem()).Elem()
+			s.SetString(transform(it.Value().String(), fns))
+			v.SetMapIndex(it.Key(), s)
+		}
+	}
+}
+
+func transform(s string, fns []Transformer) string {
+	for _, fn := range fns {
+		s = fn(s)
+	}
+	return s
+}
+
+// lookupTransformers разбирает тег mod: "trim,lower" → [trim, lower].
+func lookupTransformers(tag string) ([]Transformer, error) {
+	transformersMu.RLock()
+	defer transformersMu.RUnlock()
+
+	names := strings.Split(tag, ",")
+	fns := make([]Transformer, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		fn, ok := transformers[name]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", errUnknownTransformer, name)
+		}
+		fns = append(fns, fn)
+	}
+	return fns, nil
+}
+
+// stripZeroWidth убирает zero-width пробелы/соединители и BOM.
+func stripZeroWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// stripHTML оставляет только текст: вырезает теги, комментарии и содержимое
+// script/style, раскрывает сущности. '<' - начало разметки, только если за
+// ним буква, '/', '!' или '?' ("1 < 2" - текст). Одиночные '<' и '>'
+// удаляются, чтобы результат не собрался в разметку заново.
+func stripHTML(s string) string {
+	var b strings.Builder
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			b.WriteString(s)
+			break
+		}
+		b.WriteString(s[:i])
+		s = s[i:]
+
+		if len(s) < 2 || !isMarkupStart(s[1]) {
+			b.WriteByte('<') // не тег: уберётся вместе с одиночными '<'
+			s = s[1:]
+			continue
+		}
+		if strings.HasPrefix(s, "<!--") {
+			end := strings.Index(s, "-->")
+			if end < 0 {
+				break
+			}
+			s = s[end+3:]
+			continue
+		}
+
+		end := strings.IndexByte(s, '>')
+		if end < 0 {
+			break // незакрытый тег - отбрасываем хвост
+		}
+		name := s[1:end]
+		if i := strings.IndexFunc(name, func(r rune) bool { return unicode.IsSpace(r) || r == '/' }); i >= 0 {
+			name = name[:i]
+		}
+		s = s[end+1:]
+
+		if strings.EqualFold(name, "script") || strings.EqualFold(name, "style") {
+			closing := indexFold(s, "</"+name)
+			if closing < 0 {
+				break
+			}
+			s = s[closing:]
+		}
+	}
+
+	text := html.UnescapeString(b.String())
+	return strings.NewReplacer("<", "", ">", "").Replace(text)
+}
+
+// isMarkupStart - символ после '<', с которого начинается тег,
+// закрывающий тег, комментарий/doctype или инструкция.
+func isMarkupStart(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '/' || c == '!' || c == '?'
+}
+
+// indexFold - strings.Index без учёта регистра для ASCII-шаблона.
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
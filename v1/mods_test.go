@@ -0,0 +1,119 @@
+package httpx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTransformers(t *testing.T) {
+	tests := []struct {
+		tag, in, want string
+	}{
+		{"trim,lower", "  Foo@Example.COM ", "foo@example.com"},
+		{"ltrim", "  a  ", "a  "},
+		{"rtrim", "  a  ", "  a"},
+		{"upper", "lv", "LV"},
+		{"collapse", "  a \t b\n\nc ", "a b c"},
+		{"nozw", "pass\u200bword\ufeff", "password"},
+		{"escape", `<a href="x">`, "&lt;a href=&#34;x&#34;&gt;"},
+		{"striphtml", `Hi <b>there</b>!<script>alert(1)</script> &amp; bye`, "Hi there! & bye"},
+		{"striphtml", `a <!-- hidden --> b <STYLE>p{}</style>c`, "a  b c"},
+		{"striphtml", `&lt;script&gt;x 1 < 2`, "scriptx 1  2"},
+		{"striphtml", `1 < 2 and 3 <4> 5 <b>bold</b>`, "1  2 and 3 4 5 bold"},
+		{"striphtml", `a <3 b <`, "a 3 b "},
+		{"striphtml", `x <div`, "x "},
+		{"trim, ,lower", " A ", "a"}, // пустые имена пропускаются
+	}
+	for _, tt := range tests {
+		fns, err := lookupTransformers(tt.tag)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.tag, err)
+		}
+		if got := transform(tt.in, fns); got != tt.want {
+			t.Errorf("%s(%q) = %q, want %q", tt.tag, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestApplyMods(t *testing.T) {
+	type address struct {
+		City string `mod:"trim"`
+	}
+	type dto struct {
+		Email    string            `mod:"trim,lower"`
+		Nick     *string           `mod:"trim"`
+		Tags     []string          `mod:"trim,lower"`
+		Meta     map[string]string `mod:"upper"`
+		Address  address
+		Previous *address
+		History  []address
+		Raw      string
+		private  string `mod:"trim"`
+	}
+
+	nick := "  neo  "
+	d := dto{
+		Email:    " A@B.C ",
+		Nick:     &nick,
+		Tags:     []string{" Go ", "HTTP"},
+		Meta:     map[string]string{"k": "v"},
+		Address:  address{City: " Riga "},
+		Previous: &address{City: " Tallinn "},
+		History:  []address{{City: " Vilnius "}},
+		Raw:      " raw ",
+		private:  " p ",
+	}
+	if err := applyMods(reflect.ValueOf(&d)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := dto{
+		Email:    "a@b.c",
+		Tags:     []string{"go", "http"},
+		Meta:     map[string]string{"k": "V"},
+		Address:  address{City: "Riga"},
+		Previous: &address{City: "Tallinn"},
+		History:  []address{{City: "Vilnius"}},
+		Raw:      " raw ",
+		private:  " p ",
+	}
+	if *d.Nick != "neo" {
+		t.Errorf("Nick = %q", *d.Nick)
+	}
+	d.Nick = nil
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("got  %+v\nwant %+v", d, want)
+	}
+}
+
+func TestApplyModsUnknown(t *testing.T) {
+	d := struct {
+		Name string `mod:"trim,shout"`
+	}{}
+	if err := applyMods(reflect.ValueOf(&d)); !errors.Is(err, errUnknownTransformer) {
+		t.Errorf("err = %v, want errUnknownTransformer", err)
+	}
+}
+
+func TestRegisterTransformer(t *testing.T) {
+	RegisterTransformer("test_reverse", func(s string) string {
+		r := []rune(s)
+		for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+			r[i], r[j] = r[j], r[i]
+		}
+		return string(r)
+	})
+	t.Cleanup(func() {
+		transformersMu.Lock()
+		delete(transformers, "test_reverse")
+		transformersMu.Unlock()
+	})
+
+	d := struct {
+		Word string `mod:"trim,test_reverse"`
+	}{Word: " abc "}
+	if err := applyMods(reflect.ValueOf(&d)); err != nil || d.Word != "cba" {
+		t.Errorf("Word = %q, err = %v", d.Word, err)
+	}
+}
@@ -0,0 +1,224 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errBadRange - заголовок Range не разбирается или ни один диапазон не попадает в файл.
+var errBadRange = errors.New("httpx: invalid range")
+
+const maxRanges = 32 // больше диапазонов в одном запросе не обслуживаем
+
+// byteRange - диапазон [start, start+length).
+type byteRange struct {
+	start, length int64
+}
+
+func (br byteRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", br.start, br.start+br.length-1, size)
+}
+
+// ServeRange отдаёт content с поддержкой Range (RFC 9110):
+//   - без Range, с неподходящим If-Range, для пустого content, больше
+//     maxRanges диапазонов или перекрывающихся дороже целого - 200
+//     и всё содержимое;
+//   - один диапазон - 206 + Content-Range;
+//   - несколько - 206 multipart/byteranges;
+//   - некорректный или непопадающий Range - ErrorRangeNotSatisfiable (416)
+//     с Content-Range: bytes */size.
+//
+// Всегда ставит Accept-Ranges: bytes. If-Range сверяется с заголовком ETag
+// (выставьте его до вызова) или с modtime. Пустой contentType - определяется
+// по первым 512 байтам.
+//
+// Status: 200 OK / 206 Partial Content / 416 Range Not Satisfiable
+//
+// Пример:
+//
+//	f, _ := os.Open(path)
+//	defer f.Close()
+//	st, _ := f.Stat()
+//	httpx.ServeRange(w, r, f, "video/mp4", st.ModTime())
+func ServeRange(w http.ResponseWriter, r *http.Request, content io.ReadSeeker, contentType string, modtime time.Time) {
+	size, err := content.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = content.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		ErrorInternal(w, r, "Cannot read content")
+		return
+	}
+
+	if contentType == "" {
+		var buf [512]byte
+		n, _ := io.ReadFull(content, buf[:])
+		contentType = http.DetectContentType(buf[:n])
+		if _, err := content.Seek(0, io.SeekStart); err != nil {
+			ErrorInternal(w, r, "Cannot read content")
+			return
+		}
+	}
+
+	h := w.Header()
+	h.Set("Accept-Ranges", "bytes")
+	if !modtime.IsZero() && h.Get("Last-Modified") == "" {
+		h.Set("Last-Modified", modtime.UTC().Format(http.TimeFormat))
+	}
+
+	rangeHeader := r.Header.Get("Range")
+	// у пустого содержимого нет байтов для диапазона - отдаём его целиком
+	if size == 0 || r.Method != http.MethodGet && r.Method != http.MethodHead || !ifRangeMatches(r, h.Get("ETag"), modtime) {
+		rangeHeader = ""
+	}
+
+	ranges, err := parseRanges(rangeHeader, size)
+	if err != nil {
+		h.Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+		h.Del("Content-Disposition") // тело - JSON-ошибка, а не файл
+		ErrorRangeNotSatisfiable(w, r, "Requested range not satisfiable")
+		return
+	}
+
+	var total int64
+	for _, br := range ranges {
+		total += br.length
+	}
+	if total > size { // перекрывающиеся диапазоны дороже целого файла
+		ranges = nil
+	}
+
+	switch len(ranges) {
+	case 0:
+		h.Set("Content-Type", contentType)
+		h.Set("Content-Length", strconv.FormatInt(size, 10))
+		w.WriteHeader(http.StatusOK)
+		if r.Method != http.MethodHead {
+			_, _ = io.CopyN(w, content, size)
+		}
+
+	case 1:
+		br := ranges[0]
+		h.Set("Content-Type", contentType)
+		h.Set("Content-Range", br.contentRange(size))
+		h.Set("Content-Length", strconv.FormatInt(br.length, 10))
+		w.WriteHeader(http.StatusPartialContent)
+		if r.Method != http.MethodHead {
+			if _, err := content.Seek(br.start, io.SeekStart); err == nil {
+				_, _ = io.CopyN(w, content, br.length)
+			}
+		}
+
+	default:
+		mw := multipart.NewWriter(w)
+		h.Set("Content-Type", "multipart/byteranges; boundary="+mw.Boundary())
+		h.Del("Content-Length")
+		w.WriteHeader(http.StatusPartialContent)
+		if r.Method == http.MethodHead {
+			return
+		}
+		for _, br := range ranges {
+			part, err := mw.CreatePart(textproto.MIMEHeader{
+				"Content-Type":  {contentType},
+				"Content-Range": {br.contentRange(size)},
+			})
+			if err != nil {
+				return
+			}
+			if _, err := content.Seek(br.start, io.SeekStart); err != nil {
+				return
+			}
+			if _, err := io.CopyN(part, content, br.length); err != nil {
+				return
+			}
+		}
+		_ = mw.Close()
+	}
+}
+
+// ifRangeMatches - true, если If-Range нет или он совпадает с текущей версией:
+// сильный ETag - строго, дата - с точностью до секунды.
+func ifRangeMatches(r *http.Request, etag string, modtime time.Time) bool {
+	ir := r.Header.Get("If-Range")
+	if ir == "" {
+		return true
+	}
+	if strings.HasPrefix(ir, `"`) || strings.HasPrefix(ir, "W/") {
+		return etag != "" && !strings.HasPrefix(etag, "W/") && ir == etag
+	}
+	t, err := http.ParseTime(ir)
+	return err == nil && !modtime.IsZero() && modtime.Truncate(time.Second).Equal(t)
+}
+
+// parseRanges разбирает "bytes=0-99,200-,-500" для содержимого размера size.
+// Пустой заголовок или больше maxRanges диапазонов - nil. Диапазоны за пределами файла отбрасываются;
+// если не осталось ни одного - errBadRange.
+func parseRanges(s string, size int64) ([]byteRange, error) {
+	if s == "" {
+		return nil, nil
+	}
+	spec, ok := strings.CutPrefix(s, "bytes=")
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported unit", errBadRange)
+	}
+
+	var ranges []byteRange
+	parts := strings.Split(spec, ",")
+	if len(parts) > maxRanges {
+		return nil, nil // сервер вправе проигнорировать Range (RFC 9110 §14.2) - отдаём целиком
+	}
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		first, last, ok := strings.Cut(part, "-")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errBadRange, part)
+		}
+		first, last = strings.TrimSpace(first), strings.TrimSpace(last)
+
+		var br byteRange
+		if first == "" { // суффикс: последние N байт
+			n, err := strconv.ParseInt(last, 10, 64)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%w: %q", errBadRange, part)
+			}
+			if n == 0 || size == 0 {
+				continue
+			}
+			n = min(n, size)
+			br = byteRange{start: size - n, length: n}
+		} else {
+			start, err := strconv.ParseInt(first, 10, 64)
+			if err != nil || start < 0 {
+				return nil, fmt.Errorf("%w: %q", errBadRange, part)
+			}
+			if start >= size {
+				continue // не попадает в файл
+			}
+			end := size - 1
+			if last != "" {
+				end, err = strconv.ParseInt(last, 10, 64)
+				if err != nil || end < start {
+					return nil, fmt.Errorf("%w: %q", errBadRange, part)
+				}
+				end = min(end, size-1)
+			}
+			br = byteRange{start: start, length: end - start + 1}
+		}
+		ranges = append(ranges, br)
+	}
+
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("%w: no satisfiable range", errBadRange)
+	}
+	return ranges, nil
+}
@@ -0,0 +1,131 @@
+package httpx
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func serveRange(content, rangeHeader string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if rangeHeader != "" {
+		r.Header.Set("Range", rangeHeader)
+	}
+	rec := httptest.NewRecorder()
+	ServeRange(rec, r, strings.NewReader(content), "text/plain", time.Time{})
+	return rec
+}
+
+func TestServeRange(t *testing.T) {
+	tests := []struct {
+		name, content, rng string
+		status             int
+		body, cr           string
+	}{
+		{"no range", "0123456789", "", http.StatusOK, "0123456789", ""},
+		{"single", "0123456789", "bytes=2-4", http.StatusPartialContent, "234", "bytes 2-4/10"},
+		{"suffix", "0123456789", "bytes=-3", http.StatusPartialContent, "789", "bytes 7-9/10"},
+		{"open end", "0123456789", "bytes=8-", http.StatusPartialContent, "89", "bytes 8-9/10"},
+		{"past end", "0123456789", "bytes=20-30", http.StatusRequestedRangeNotSatisfiable, "", "bytes */10"},
+		{"empty content", "", "bytes=0-", http.StatusOK, "", ""},
+		{"empty content suffix", "", "bytes=-10", http.StatusOK, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveRange(tt.content, tt.rng)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if got := rec.Header().Get("Content-Range"); got != tt.cr {
+				t.Errorf("Content-Range = %q, want %q", got, tt.cr)
+			}
+			if tt.status != http.StatusRequestedRangeNotSatisfiable && rec.Body.String() != tt.body {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.body)
+			}
+		})
+	}
+}
+
+func TestServeRangeMultipart(t *testing.T) {
+	rec := serveRange("0123456789", "bytes=0-1,5-6")
+	if rec.Code != http.StatusPartialContent {
+		t.Fatalf("status = %d, want 206", rec.Code)
+	}
+	mt, params, err := mime.ParseMediaType(rec.Header().Get("Content-Type"))
+	if err != nil || mt != "multipart/byteranges" {
+		t.Fatalf("Content-Type = %q", rec.Header().Get("Content-Type"))
+	}
+
+	mr := multipart.NewReader(rec.Body, params["boundary"])
+	var parts []string
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(p)
+		parts = append(parts, p.Header.Get("Content-Range")+" "+string(b))
+	}
+	if want := "bytes 0-1/10 01|bytes 5-6/10 56"; strings.Join(parts, "|") != want {
+		t.Errorf("parts = %q, want %q", parts, want)
+	}
+}
+
+func TestServeRangeIfRange(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	content := "0123456789"
+
+	tests := []struct {
+		name    string
+		method  string
+		etag    string // ETag ответа, выставленный до ServeRange
+		ifRange string
+		rng     string
+		status  int
+		body    string
+	}{
+		{"strong etag match", http.MethodGet, `"v1"`, `"v1"`, "bytes=0-3", http.StatusPartialContent, "0123"},
+		{"strong etag mismatch", http.MethodGet, `"v2"`, `"v1"`, "bytes=0-3", http.StatusOK, content},
+		{"weak etag in If-Range", http.MethodGet, `"v1"`, `W/"v1"`, "bytes=0-3", http.StatusOK, content},
+		{"weak response etag", http.MethodGet, `W/"v1"`, `W/"v1"`, "bytes=0-3", http.StatusOK, content},
+		{"date match", http.MethodGet, "", modified.Format(http.TimeFormat), "bytes=0-3", http.StatusPartialContent, "0123"},
+		{"stale date", http.MethodGet, "", modified.Add(-time.Hour).Format(http.TimeFormat), "bytes=0-3", http.StatusOK, content},
+		{"bad date", http.MethodGet, "", "yesterday", "bytes=0-3", http.StatusOK, content},
+		{"head", http.MethodHead, `"v1"`, `"v1"`, "bytes=0-3", http.StatusPartialContent, ""},
+		{"post ignores range", http.MethodPost, "", "", "bytes=0-3", http.StatusOK, content},
+		{"overlapping", http.MethodGet, "", "", "bytes=0-6,2-9,0-9", http.StatusOK, content},
+		{"too many", http.MethodGet, "", "", "bytes=" + strings.Repeat("0-0,", maxRanges) + "1-1", http.StatusOK, content},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/", nil)
+			r.Header.Set("Range", tt.rng)
+			if tt.ifRange != "" {
+				r.Header.Set("If-Range", tt.ifRange)
+			}
+			rec := httptest.NewRecorder()
+			if tt.etag != "" {
+				rec.Header().Set("ETag", tt.etag)
+			}
+			ServeRange(rec, r, strings.NewReader(content), "text/plain", modified)
+
+			if rec.Code != tt.status || rec.Body.String() != tt.body {
+				t.Fatalf("status %d body %q, want %d %q", rec.Code, rec.Body.String(), tt.status, tt.body)
+			}
+			if tt.method == http.MethodHead && rec.Header().Get("Content-Length") != "4" {
+				t.Errorf("HEAD Content-Length = %q, want 4", rec.Header().Get("Content-Length"))
+			}
+			if tt.status == http.StatusOK && rec.Header().Get("Content-Range") != "" {
+				t.Errorf("Content-Range on 200: %q", rec.Header().Get("Content-Range"))
+			}
+		})
+	}
+}
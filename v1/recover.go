@@ -0,0 +1,103 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// OnPanic получает значение паники и стек из Recover. nil - запись в
+// slog.Default() с уровнем Error.
+//
+// Пример:
+//
+//	httpx.OnPanic = func(r *http.Request, v any, stack []byte) {
+//	    sentry.CurrentHub().Recover(v)
+//	}
+var OnPanic func(r *http.Request, v any, stack []byte)
+
+// Recover - middleware: перехватывает панику хендлера, сообщает о ней в
+// OnPanic и отвечает ErrorInternal (Envelope с trace_id). Если заголовки
+// уже отправлены, ответить нельзя - паника повторяется как
+// http.ErrAbortHandler, и net/http обрывает соединение, чтобы клиент не
+// принял обрезанный ответ за полный. http.ErrAbortHandler хендлера
+// пробрасывается дальше как есть.
+//
+// Замена chi middleware.Recoverer, который отвечает text/plain.
+//
+// Пример:
+//
+//	r.Use(middleware.RequestID, httpx.Recover)
+func Recover(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rw := &recoverWriter{ResponseWriter: w}
+		defer func() {
+			v := recover()
+			if v == nil {
+				return
+			}
+			if err, ok := v.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(v)
+			}
+
+			stack := debug.Stack()
+			reportPanic(r, v, stack)
+			if rw.wrote {
+				panic(http.ErrAbortHandler)
+			}
+			writeError(w, r, http.StatusInternalServerError, ErrorCode(http.StatusInternalServerError), "Internal server error", nil, panicError(v), stack)
+		}()
+		next.ServeHTTP(rw, r)
+	})
+}
+
+// panicError - значение паники как причина для Debug.
+func panicError(v any) error {
+	if err, ok := v.(error); ok {
+		return fmt.Errorf("panic: %w", err)
+	}
+	return fmt.Errorf("panic: %v", v)
+}
+
+func reportPanic(r *http.Request, v any, stack []byte) {
+	if OnPanic != nil {
+		OnPanic(r, v, stack)
+		return
+	}
+	slog.Default().ErrorContext(r.Context(), "httpx: panic recovered",
+		"panic", v,
+		"method", r.Method,
+		"path", r.URL.Path,
+		"trace_id", TraceID(r),
+		"stack", string(stack),
+	)
+}
+
+// recoverWriter запоминает, ушли ли клиенту заголовки.
+type recoverWriter struct {
+	http.ResponseWriter
+	wrote bool
+}
+
+func (rw *recoverWriter) WriteHeader(status int) {
+	if status >= http.StatusOK || status == http.StatusSwitchingProtocols {
+		rw.wrote = true
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *recoverWriter) Write(b []byte) (int, error) {
+	rw.wrote = true
+	return rw.ResponseWriter.Write(b)
+}
+
+func (rw *recoverWriter) Flush() {
+	rw.wrote = true
+	_ = http.NewResponseController(rw.ResponseWriter).Flush()
+}
+
+func (rw *recoverWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
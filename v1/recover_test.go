@@ -0,0 +1,97 @@
+package httpx
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRecover(t *testing.T) {
+	prev := OnPanic
+	var reported any
+	OnPanic = func(r *http.Request, v any, stack []byte) { reported = v }
+	t.Cleanup(func() { OnPanic = prev })
+
+	panicky := Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/late" {
+			w.WriteHeader(http.StatusAccepted)
+		}
+		panic("boom")
+	}))
+
+	for _, debug := range []bool{false, true} {
+		t.Run(fmt.Sprint("debug=", debug), func(t *testing.T) {
+			withDebug(t, debug)
+			reported = nil
+
+			rec := httptest.NewRecorder()
+			panicky.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			b := decodeError(t, rec)
+
+			if rec.Code != http.StatusInternalServerError || b.Error.Code != "INTERNAL" {
+				t.Fatalf("status %d code %s", rec.Code, b.Error.Code)
+			}
+			if reported != "boom" {
+				t.Fatalf("OnPanic got %v", reported)
+			}
+			if got := strings.Contains(string(b.Error.Details), "panic: boom"); got != debug {
+				t.Fatalf("panic in details = %v, want %v: %s", got, debug, b.Error.Details)
+			}
+		})
+	}
+
+	t.Run("headers already sent", func(t *testing.T) {
+		reported = nil
+		rec := httptest.NewRecorder()
+		defer func() {
+			// ответ уже начат - соединение обрывается, паника всё равно учтена
+			if v := recover(); v != http.ErrAbortHandler {
+				t.Fatalf("recovered %v, want http.ErrAbortHandler", v)
+			}
+			if reported != "boom" {
+				t.Errorf("OnPanic got %v", reported)
+			}
+			if rec.Code != http.StatusAccepted || rec.Body.Len() != 0 {
+				t.Errorf("status %d body %q, want untouched 202", rec.Code, rec.Body.String())
+			}
+		}()
+		panicky.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/late", nil))
+	})
+
+	t.Run("abort handler", func(t *testing.T) {
+		defer func() {
+			if v := recover(); v != http.ErrAbortHandler {
+				t.Fatalf("recovered %v, want http.ErrAbortHandler", v)
+			}
+		}()
+		Recover(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			panic(http.ErrAbortHandler)
+		})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	})
+}
+
+func TestRecoverAbortsStartedResponse(t *testing.T) {
+	prev := OnPanic
+	OnPanic = func(*http.Request, any, []byte) {}
+	t.Cleanup(func() { OnPanic = prev })
+
+	srv := httptest.NewServer(Recover(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("partial"))
+		_ = http.NewResponseController(w).Flush()
+		panic("boom")
+	})))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	// клиент видит обрыв, а не «успешный» обрезанный ответ
+	if body, err := io.ReadAll(resp.Body); err == nil {
+		t.Fatalf("body %q read without error, want aborted connection", body)
+	}
+}
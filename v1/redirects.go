@@ -75,6 +75,7 @@ func RedirectSeeOther(w http.ResponseWriter, r *http.Request, location string) {
 //
 // Особый случай: тело отсутствует; Location не нужен.
 // Вызывайте, если ETag / If‑Modified‑Since совпали.
+// Для JSON-ответов это делает middleware ETag / WeakETag.
 //
 // Status: 304 Not Modified
 func RedirectNotModified(w http.ResponseWriter, r *http.Request) {
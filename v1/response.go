@@ -3,13 +3,79 @@ package httpx
 import (
 	"encoding/json"
 	"net/http"
-
-	"github.com/go-chi/chi/middleware"
 )
 
+// errorCodes - machine-code для 4xx/5xx-статуса: единая таблица для
+// хелперов Error*, Batch и MultiStatus.
+var errorCodes = map[int]string{
+	http.StatusBadRequest:                   "BAD_REQUEST",
+	http.StatusUnauthorized:                 "UNAUTHORIZED",
+	http.StatusPaymentRequired:              "PAYMENT_REQUIRED",
+	http.StatusForbidden:                    "FORBIDDEN",
+	http.StatusNotFound:                     "NOT_FOUND",
+	http.StatusMethodNotAllowed:             "METHOD_NOT_ALLOWED",
+	http.StatusNotAcceptable:                "NOT_ACCEPTABLE",
+	http.StatusProxyAuthRequired:            "PROXY_AUTH_REQUIRED",
+	http.StatusRequestTimeout:               "REQUEST_TIMEOUT",
+	http.StatusConflict:                     "CONFLICT",
+	http.StatusGone:                         "GONE",
+	http.StatusLengthRequired:               "LENGTH_REQUIRED",
+	http.StatusPreconditionFailed:           "PRECONDITION_FAILED",
+	http.StatusRequestEntityTooLarge:        "PAYLOAD_TOO_LARGE",
+	http.StatusRequestURITooLong:            "URI_TOO_LONG",
+	http.StatusUnsupportedMediaType:         "UNSUPPORTED_MEDIA_TYPE",
+	http.StatusRequestedRangeNotSatisfiable: "RANGE_NOT_SATISFIABLE",
+	http.StatusExpectationFailed:            "EXPECTATION_FAILED",
+	http.StatusTeapot:                       "TEAPOT",
+	http.StatusMisdirectedRequest:           "MISDIRECTED_REQUEST",
+	http.StatusUnprocessableEntity:          "UNPROCESSABLE",
+	http.StatusLocked:                       "LOCKED",
+	http.StatusFailedDependency:             "FAILED_DEPENDENCY",
+	http.StatusTooEarly:                     "TOO_EARLY",
+	http.StatusUpgradeRequired:              "UPGRADE_REQUIRED",
+	http.StatusPreconditionRequired:         "PRECONDITION_REQUIRED",
+	http.StatusTooManyRequests:              "RATE_LIMIT",
+	http.StatusRequestHeaderFieldsTooLarge:  "HEADER_FIELDS_TOO_LARGE",
+	http.StatusUnavailableForLegalReasons:   "LEGAL_REASONS",
+
+	http.StatusInternalServerError:           "INTERNAL",
+	http.StatusNotImplemented:                "NOT_IMPLEMENTED",
+	http.StatusBadGateway:                    "BAD_GATEWAY",
+	http.StatusServiceUnavailable:            "SERVICE_UNAVAILABLE",
+	http.StatusGatewayTimeout:                "TIMEOUT",
+	http.StatusHTTPVersionNotSupported:       "VERSION_NOT_SUPPORTED",
+	http.StatusVariantAlsoNegotiates:         "VARIANT_NEGOTIATES",
+	http.StatusInsufficientStorage:           "INSUFFICIENT_STORAGE",
+	http.StatusLoopDetected:                  "LOOP_DETECTED",
+	http.StatusNotExtended:                   "NOT_EXTENDED",
+	http.StatusNetworkAuthenticationRequired: "NETWORK_AUTH_REQUIRED",
+}
+
+// ErrorCode возвращает machine-code для 4xx/5xx-статуса - тот же, что
+// ставит соответствующий хелпер Error*. Для неизвестных статусов: "ERROR".
+func ErrorCode(status int) string {
+	if code, ok := errorCodes[status]; ok {
+		return code
+	}
+	return "ERROR"
+}
+
 // Error формирует структурированный ответ с ошибкой (status 4xx, 5xx)
+//
+// Для 5xx сообщение и details зависят от режима (см. Debug).
 func Error(w http.ResponseWriter, r *http.Request, status int, code, message string, details interface{}) {
-	traceID := middleware.GetReqID(r.Context())
+	writeError(w, r, status, code, message, details, nil, nil)
+}
+
+// writeError - Error с причиной и стеком для режима Debug (ErrorInternalCause, Recover).
+func writeError(w http.ResponseWriter, r *http.Request, status int, code, message string, details any, cause error, stack []byte) {
+	logError(r, status, code, message, details, cause)
+	recordErrorCode(r, code)
+
+	traceID := TraceID(r)
+	if status >= http.StatusInternalServerError {
+		message, details = serverErrorBody(r, status, message, details, cause, stack)
+	}
 
 	resp := Envelope{
 		Success: false,
@@ -21,6 +87,7 @@ func Error(w http.ResponseWriter, r *http.Request, status int, code, message str
 		TraceID: traceID,
 	}
 
+	applyErrorCache(w, r, status) // ошибки не кешируются, кроме настроенных 404/410
 	writeJSON(w, status, resp)
 }
 
@@ -30,7 +97,7 @@ func Error(w http.ResponseWriter, r *http.Request, status int, code, message str
 //
 //	httpx.JSON(w, r, http.StatusCreated, myObject)
 func JSON(w http.ResponseWriter, r *http.Request, status int, data interface{}) {
-	traceID := middleware.GetReqID(r.Context())
+	traceID := TraceID(r)
 
 	resp := Envelope{
 		Success: true,
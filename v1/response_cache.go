@@ -0,0 +1,436 @@
+package httpx
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultCacheBytes = 64 << 20 // 64 MiB - размер MemoryStore по умолчанию
+
+// CachedResponse - закешированный ответ. Поля экспортированы, чтобы внешнее
+// хранилище могло его сериализовать (например, в JSON для Redis).
+type CachedResponse struct {
+	Status   int         `json:"status"`
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+	StoredAt time.Time   `json:"stored_at"`
+	TraceID  string      `json:"trace_id,omitempty"` // trace_id в Body, заменяется на текущий
+}
+
+// ResponseStore - хранилище ResponseCache. Get возвращает nil, nil при
+// промахе; ошибки хранилища middleware считает промахом и не прерывает запрос.
+type ResponseStore interface {
+	Get(ctx context.Context, key string) (*CachedResponse, error)
+	Set(ctx context.Context, key string, resp *CachedResponse, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+// ResponseCacheConfig - настройки ResponseCache.
+type ResponseCacheConfig struct {
+	// Store - хранилище; nil - NewMemoryStore(0).
+	Store ResponseStore
+
+	// TTL - время жизни ответа без s-maxage / max-age в Cache-Control;
+	// 0 - такие ответы не кешируются.
+	TTL time.Duration
+
+	// VaryHeaders - заголовки запроса, входящие в ключ (язык учитывается
+	// всегда - по TranslatorFor). Authorization или Cookie в списке
+	// включает кеширование запросов с ними; иначе они идут мимо кеша.
+	VaryHeaders []string
+
+	// Key - свой ключ вместо метода, хоста, пути, query, VaryHeaders и языка;
+	// пустая строка - запрос мимо кеша.
+	Key func(r *http.Request) string
+}
+
+// ResponseCache - middleware: кеширует готовые ответы идемпотентных GET.
+//
+// Ключ - метод, хост, путь, query (порядок параметров не важен),
+// VaryHeaders и язык ответа. Кешируются 200, 404 и 410 без no-store,
+// no-cache, private и Set-Cookie; срок - s-maxage, max-age или TTL.
+// Ответ с Vary по заголовку вне ключа (не из VaryHeaders, Accept-Language
+// и Accept-Encoding - сжатие снаружи, см. Compress) или Vary: * не кешируется.
+// Запросы с Authorization или Cookie (если их нет в VaryHeaders) и с
+// Cache-Control: no-store идут мимо кеша, с no-cache или
+// max-age=0 - обновляют запись. Одновременные промахи по одному ключу
+// ждут один вызов хендлера; если он начал стримить (Flush) или тело
+// выросло больше 4 MiB, ждущие отпускаются и зовут хендлер сами.
+//
+// Из кеша отвечает с X-Cache: HIT и Age, на If-None-Match /
+// If-Modified-Since - 304 по сохранённым ETag и Last-Modified. trace_id
+// в теле заменяется на текущий. Потоковые ответы и ответы больше 4 MiB
+// не кешируются.
+//
+// Пример:
+//
+//	rc := httpx.ResponseCache(httpx.ResponseCacheConfig{TTL: 30 * time.Second})
+//	r.With(rc, httpx.ETag).Get("/articles/{id}", getArticle)
+func ResponseCache(cfg ResponseCacheConfig) func(http.Handler) http.Handler {
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStore(0)
+	}
+	if cfg.Key == nil {
+		cfg.Key = func(r *http.Request) string { return responseCacheKey(r, cfg.VaryHeaders) }
+	}
+	// Запросы с учётными данными идут мимо кеша, если заголовок не входит в ключ.
+	var private []string
+	for _, name := range []string{"Authorization", "Cookie"} {
+		if !slices.ContainsFunc(cfg.VaryHeaders, func(h string) bool { return strings.EqualFold(h, name) }) {
+			private = append(private, name)
+		}
+	}
+	// Заголовки, по которым ответ может варьироваться без риска отдать чужой.
+	keyed := append([]string{"Accept-Language", "Accept-Encoding"}, cfg.VaryHeaders...)
+	group := &flightGroup{calls: map[string]*flightCall{}}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+			reqCC := cacheDirectives(r.Header.Get("Cache-Control"))
+			if _, ok := reqCC["no-store"]; ok || hasAnyHeader(r, private) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			key := cfg.Key(r)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			_, refresh := reqCC["no-cache"]
+			refresh = refresh || reqCC["max-age"] == "0"
+			if !refresh {
+				if e, err := cfg.Store.Get(ctx, key); err == nil && e != nil {
+					serveCached(w, r, e, true)
+					return
+				}
+			}
+			if r.Method == http.MethodHead { // тело HEAD не сохранить
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			c, leader := group.join(key)
+			if !leader {
+				select {
+				case <-c.done:
+				case <-ctx.Done():
+					return
+				}
+				if c.resp != nil {
+					serveCached(w, r, c.resp, true)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+			defer group.leave(key, c)
+
+			// Хендлеру - безусловный запрос: в кеш нужно полное тело, 304
+			// ответим сами.
+			r2 := r.Clone(ctx)
+			r2.Header.Del("If-None-Match")
+			r2.Header.Del("If-Modified-Since")
+
+			// Потоковый ответ не кешируется - ждущих не держим до его конца.
+			bw := &etagWriter{ResponseWriter: w, status: http.StatusOK, onPassthrough: func() { group.leave(key, c) }}
+			next.ServeHTTP(bw, r2)
+			if bw.passthrough {
+				return
+			}
+
+			ttl := cacheTTL(bw.status, w.Header(), cfg.TTL)
+			if ttl <= 0 || !varyCovered(w.Header(), keyed) {
+				bw.flushBuffer()
+				return
+			}
+			c.resp = &CachedResponse{
+				Status:   bw.status,
+				Header:   w.Header().Clone(),
+				Body:     bytes.Clone(bw.buf.Bytes()),
+				StoredAt: time.Now(),
+				TraceID:  TraceID(r),
+			}
+			_ = cfg.Store.Set(ctx, key, c.resp, ttl)
+			serveCached(w, r, c.resp, false)
+		})
+	}
+}
+
+func hasAnyHeader(r *http.Request, names []string) bool {
+	for _, name := range names {
+		if r.Header.Get(name) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// serveCached отвечает сохранённым ответом (или 304 по его валидаторам).
+func serveCached(w http.ResponseWriter, r *http.Request, e *CachedResponse, hit bool) {
+	h := w.Header()
+	traceID := TraceID(r)
+	for k, v := range e.Header {
+		// Заголовок с trace_id исходного запроса (EchoTraceID) - на текущий.
+		if e.TraceID != "" && len(v) == 1 && v[0] == e.TraceID {
+			if traceID != "" {
+				h[k] = []string{traceID}
+			}
+			continue
+		}
+		h[k] = append([]string(nil), v...)
+	}
+	if hit {
+		h.Set("X-Cache", "HIT")
+		h.Set("Age", strconv.FormatInt(int64(time.Since(e.StoredAt)/time.Second), 10))
+	} else {
+		h.Set("X-Cache", "MISS")
+	}
+
+	var modtime time.Time
+	if lm := h.Get("Last-Modified"); lm != "" {
+		modtime, _ = http.ParseTime(lm)
+	}
+	if e.Status == http.StatusOK && notModified(r, h.Get("ETag"), modtime) {
+		h.Del("Content-Type")
+		h.Del("Content-Length")
+		RedirectNotModified(w, r)
+		return
+	}
+
+	body := retrace(e.Body, e.TraceID, traceID)
+	h.Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(e.Status)
+	if r.Method != http.MethodHead {
+		_, _ = w.Write(body)
+	}
+}
+
+// retrace заменяет trace_id исходного запроса в теле Envelope на текущий
+// (только поле верхнего уровня, см. cutTraceID).
+func retrace(body []byte, from, to string) []byte {
+	if from == "" || from == to {
+		return body
+	}
+	rest, ok := cutTraceID(body, from)
+	cur, err := json.Marshal(to)
+	if !ok || to == "" || err != nil {
+		return rest
+	}
+	end := bytes.LastIndexByte(rest, '}')
+	out := make([]byte, 0, len(rest)+len(cur)+12)
+	out = append(out, rest[:end]...)
+	out = append(out, `,"trace_id":`...)
+	out = append(out, cur...)
+	return append(out, rest[end:]...)
+}
+
+// cacheTTL - срок хранения ответа; 0 - ответ кешировать нельзя.
+func cacheTTL(status int, h http.Header, def time.Duration) time.Duration {
+	switch status {
+	case http.StatusOK, http.StatusNotFound, http.StatusGone:
+	default:
+		return 0
+	}
+	if h.Get("Set-Cookie") != "" {
+		return 0
+	}
+
+	cc := cacheDirectives(h.Get("Cache-Control"))
+	for _, d := range []string{"no-store", "no-cache", "private"} {
+		if _, ok := cc[d]; ok {
+			return 0
+		}
+	}
+	for _, d := range []string{"s-maxage", "max-age"} {
+		if v, ok := cc[d]; ok {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || n <= 0 {
+				return 0
+			}
+			return time.Duration(n) * time.Second
+		}
+	}
+	return def
+}
+
+// varyCovered - все поля Vary ответа входят в keyed (Vary: * - никогда).
+func varyCovered(h http.Header, keyed []string) bool {
+	for _, v := range h.Values("Vary") {
+		for _, f := range strings.Split(v, ",") {
+			f = strings.TrimSpace(f)
+			if f != "" && !slices.ContainsFunc(keyed, func(k string) bool { return strings.EqualFold(k, f) }) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// cacheDirectives разбирает Cache-Control: "public, max-age=60" →
+// {"public": "", "max-age": "60"}.
+func cacheDirectives(v string) map[string]string {
+	d := map[string]string{}
+	for _, part := range strings.Split(v, ",") {
+		name, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+		if name != "" {
+			d[strings.ToLower(name)] = strings.Trim(val, `"`)
+		}
+	}
+	return d
+}
+
+// responseCacheKey - sha256 от метода, хоста, пути, query, заголовков vary и языка.
+func responseCacheKey(r *http.Request, vary []string) string {
+	locale := ""
+	if tr := TranslatorFor(r); tr != nil {
+		locale = tr.Locale()
+	}
+
+	h := sha256.New()
+	for _, s := range []string{http.MethodGet, r.Host, r.URL.Path, r.URL.Query().Encode(), locale} {
+		h.Write([]byte(s))
+		h.Write([]byte{'\n'})
+	}
+	for _, name := range vary {
+		h.Write([]byte(strings.ToLower(name) + ":" + strings.Join(r.Header.Values(name), ",") + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// flightGroup склеивает одновременные промахи по одному ключу.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	once sync.Once
+	done chan struct{}
+	resp *CachedResponse // nil - ответ не кешируемый, ждавшие зовут хендлер сами
+}
+
+// join возвращает текущий вызов по key; leader - вызов создан этим запросом.
+func (g *flightGroup) join(key string) (c *flightCall, leader bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if c, ok := g.calls[key]; ok {
+		return c, false
+	}
+	c = &flightCall{done: make(chan struct{})}
+	g.calls[key] = c
+	return c, true
+}
+
+// leave завершает вызов и отпускает ждущих; повторный вызов ничего не делает.
+func (g *flightGroup) leave(key string, c *flightCall) {
+	c.once.Do(func() {
+		g.mu.Lock()
+		if g.calls[key] == c {
+			delete(g.calls, key)
+		}
+		g.mu.Unlock()
+		close(c.done)
+	})
+}
+
+// MemoryStore - ResponseStore в памяти процесса: TTL и LRU-вытеснение
+// по суммарному размеру ответов.
+type MemoryStore struct {
+	mu       sync.Mutex
+	maxBytes int64
+	size     int64
+	ll       *list.List // от недавно использованных к давним
+	items    map[string]*list.Element
+}
+
+type memEntry struct {
+	key     string
+	resp    *CachedResponse
+	expires time.Time
+	size    int64
+}
+
+// NewMemoryStore создаёт хранилище на maxBytes байт (0 - 64 MiB).
+func NewMemoryStore(maxBytes int64) *MemoryStore {
+	if maxBytes <= 0 {
+		maxBytes = defaultCacheBytes
+	}
+	return &MemoryStore{maxBytes: maxBytes, ll: list.New(), items: map[string]*list.Element{}}
+}
+
+// Get возвращает непросроченную запись и поднимает её в начало LRU.
+func (s *MemoryStore) Get(_ context.Context, key string) (*CachedResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.items[key]
+	if !ok {
+		return nil, nil
+	}
+	e := el.Value.(*memEntry)
+	if time.Now().After(e.expires) {
+		s.remove(el)
+		return nil, nil
+	}
+	s.ll.MoveToFront(el)
+	return e.resp, nil
+}
+
+// Set сохраняет ответ на ttl, вытесняя давно не использованные записи.
+// Ответ больше maxBytes молча не сохраняется.
+func (s *MemoryStore) Set(_ context.Context, key string, resp *CachedResponse, ttl time.Duration) error {
+	size := int64(len(key) + len(resp.Body))
+	for k, vs := range resp.Header {
+		size += int64(len(k))
+		for _, v := range vs {
+			size += int64(len(v))
+		}
+	}
+	if size > s.maxBytes {
+		return nil // не влезет даже в пустое хранилище
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.items[key]; ok {
+		s.remove(el)
+	}
+	s.items[key] = s.ll.PushFront(&memEntry{key: key, resp: resp, expires: time.Now().Add(ttl), size: size})
+	s.size += size
+	for s.size > s.maxBytes {
+		s.remove(s.ll.Back())
+	}
+	return nil
+}
+
+// Delete удаляет запись (инвалидация по ключу).
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.items[key]; ok {
+		s.remove(el)
+	}
+	return nil
+}
+
+// remove удаляет запись; вызывать под s.mu.
+func (s *MemoryStore) remove(el *list.Element) {
+	e := s.ll.Remove(el).(*memEntry)
+	delete(s.items, e.key)
+	s.size -= e.size
+}
@@ -0,0 +1,261 @@
+package httpx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingHandler отвечает JSON и считает вызовы.
+func countingHandler(calls *atomic.Int32, delay time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(delay)
+		JSON(w, r, http.StatusOK, r.URL.Query().Get("q"))
+	})
+}
+
+func get(h http.Handler, target string, header ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, target, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		r.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	return rec
+}
+
+func TestResponseCacheHit(t *testing.T) {
+	var calls atomic.Int32
+	h := ResponseCache(ResponseCacheConfig{TTL: time.Minute})(ETag(countingHandler(&calls, 0)))
+
+	first := get(h, "/a?q=1&z=2")
+	second := get(h, "/a?z=2&q=1") // порядок параметров не важен
+	if calls.Load() != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls.Load())
+	}
+	if first.Header().Get("X-Cache") != "MISS" || second.Header().Get("X-Cache") != "HIT" {
+		t.Fatalf("X-Cache = %s, %s", first.Header().Get("X-Cache"), second.Header().Get("X-Cache"))
+	}
+	if first.Body.String() != second.Body.String() {
+		t.Fatalf("bodies differ: %s vs %s", first.Body.String(), second.Body.String())
+	}
+
+	nm := get(h, "/a?q=1&z=2", "If-None-Match", first.Header().Get("ETag"))
+	if nm.Code != http.StatusNotModified || nm.Body.Len() != 0 {
+		t.Fatalf("conditional hit: status %d body %q, want 304", nm.Code, nm.Body.String())
+	}
+}
+
+func TestResponseCacheKey(t *testing.T) {
+	var calls atomic.Int32
+	h := ResponseCache(ResponseCacheConfig{TTL: time.Minute})(countingHandler(&calls, 0))
+
+	get(h, "/a")
+	get(h, "/a", "Accept-Language", "ru") // язык ответа - часть ключа
+	get(h, "/a", "Accept-Language", "ru")
+	get(h, "/a?q=2")
+
+	want := int32(3)
+	if _, ok := translator("ru"); !ok {
+		want = 2 // httpx_minimal: ru нет, ответ на английском
+	}
+	if calls.Load() != want {
+		t.Fatalf("handler calls = %d, want %d", calls.Load(), want)
+	}
+}
+
+func TestResponseCacheBypass(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    ResponseCacheConfig
+		header []string
+		cached bool
+	}{
+		{"authorization", ResponseCacheConfig{}, []string{"Authorization", "Bearer x"}, false},
+		{"cookie", ResponseCacheConfig{}, []string{"Cookie", "session=abc"}, false},
+		{"cookie in key", ResponseCacheConfig{VaryHeaders: []string{"Cookie"}}, []string{"Cookie", "session=abc"}, true},
+		{"no-store", ResponseCacheConfig{}, []string{"Cache-Control", "no-store"}, false},
+		{"plain", ResponseCacheConfig{}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			tt.cfg.TTL = time.Minute
+			h := ResponseCache(tt.cfg)(countingHandler(&calls, 0))
+
+			get(h, "/me", tt.header...)
+			get(h, "/me", tt.header...)
+			if cached := calls.Load() == 1; cached != tt.cached {
+				t.Fatalf("handler calls = %d, cached = %v, want %v", calls.Load(), cached, tt.cached)
+			}
+		})
+	}
+}
+
+func TestResponseCacheRespectsCacheControl(t *testing.T) {
+	var calls atomic.Int32
+	h := ResponseCache(ResponseCacheConfig{TTL: time.Minute})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		switch r.URL.Path {
+		case "/private":
+			w.Header().Set("Cache-Control", "private, max-age=60")
+			JSON(w, r, http.StatusOK, 1)
+		case "/error":
+			ErrorConflict(w, r, "x") // ошибки - no-store
+		}
+	}))
+
+	for _, path := range []string{"/private", "/error"} {
+		calls.Store(0)
+		get(h, path)
+		get(h, path)
+		if calls.Load() != 2 {
+			t.Errorf("%s: handler calls = %d, want 2 (not cached)", path, calls.Load())
+		}
+	}
+}
+
+func TestResponseCacheCoalescing(t *testing.T) {
+	var calls atomic.Int32
+	h := ResponseCache(ResponseCacheConfig{TTL: time.Minute})(countingHandler(&calls, 50*time.Millisecond))
+
+	var wg sync.WaitGroup
+	codes := make([]int, 8)
+	for i := range codes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codes[i] = get(h, "/slow").Code
+		}()
+	}
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("handler calls = %d, want 1", calls.Load())
+	}
+	for i, code := range codes {
+		if code != http.StatusOK {
+			t.Fatalf("request %d: status %d", i, code)
+		}
+	}
+}
+
+func TestResponseCacheVary(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    ResponseCacheConfig
+		vary   string
+		cached bool
+	}{
+		{"no vary", ResponseCacheConfig{}, "", true},
+		{"language", ResponseCacheConfig{}, "Accept-Language", true},
+		{"encoding", ResponseCacheConfig{}, "accept-encoding", true},
+		{"configured", ResponseCacheConfig{VaryHeaders: []string{"X-Tenant"}}, "Accept-Language, x-tenant", true},
+		{"outside key", ResponseCacheConfig{}, "X-Tenant", false},
+		{"partly outside", ResponseCacheConfig{VaryHeaders: []string{"X-Tenant"}}, "X-Tenant, X-Region", false},
+		{"star", ResponseCacheConfig{}, "*", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			tt.cfg.TTL = time.Minute
+			h := ResponseCache(tt.cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				if tt.vary != "" {
+					w.Header().Set("Vary", tt.vary)
+				}
+				JSON(w, r, http.StatusOK, "ok")
+			}))
+			get(h, "/")
+			get(h, "/")
+			if cached := calls.Load() == 1; cached != tt.cached {
+				t.Errorf("handler calls = %d, cached = %v, want %v", calls.Load(), cached, tt.cached)
+			}
+		})
+	}
+}
+
+func TestResponseCacheStreamReleasesWaiters(t *testing.T) {
+	flushed, release := make(chan struct{}), make(chan struct{})
+	var calls atomic.Int32
+	h := ResponseCache(ResponseCacheConfig{TTL: time.Minute})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			_, _ = w.Write([]byte("event"))
+			_ = http.NewResponseController(w).Flush()
+			close(flushed)
+			<-release // лидер стримит дальше
+			return
+		}
+		_, _ = w.Write([]byte("other"))
+	}))
+	defer close(release)
+
+	go get(h, "/events")
+	<-flushed
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- get(h, "/events") }()
+	select {
+	case rec := <-done:
+		if rec.Body.String() != "other" {
+			t.Errorf("waiter: body %q", rec.Body.String())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiter blocked by streaming leader")
+	}
+}
+
+func TestRetrace(t *testing.T) {
+	tests := []struct {
+		body, from, to, want string
+	}{
+		{`{"success":true,"trace_id":"aaa"}` + "\n", "aaa", "bbb", `{"success":true,"trace_id":"bbb"}` + "\n"},
+		{`{"success":true,"trace_id":"aaa"}`, "aaa", "", `{"success":true}`},
+		{`{"data":{"x":1,"trace_id":"aaa"}}`, "aaa", "bbb", `{"data":{"x":1,"trace_id":"aaa"}}`},
+		{`{"data":",\"trace_id\":\"aaa\"","trace_id":"aaa"}`, "aaa", "bbb", `{"data":",\"trace_id\":\"aaa\"","trace_id":"bbb"}`},
+	}
+	for _, tt := range tests {
+		if got := string(retrace([]byte(tt.body), tt.from, tt.to)); got != tt.want {
+			t.Errorf("retrace(%s, %q, %q) = %s, want %s", tt.body, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	resp := func() *CachedResponse { return &CachedResponse{Body: make([]byte, 100)} }
+
+	s := NewMemoryStore(300)
+	for i := range 5 {
+		_ = s.Set(ctx, fmt.Sprint(i), resp(), time.Minute)
+		if i == 2 {
+			_, _ = s.Get(ctx, "0") // 0 - недавно использован, вытеснится 1
+		}
+	}
+	for key, want := range map[string]bool{"0": false, "1": false, "2": false, "3": true, "4": true} {
+		got, _ := s.Get(ctx, key)
+		if (got != nil) != want {
+			t.Errorf("Get(%s) present = %v, want %v", key, got != nil, want)
+		}
+	}
+	if s.size > 300 {
+		t.Errorf("size = %d, want <= 300", s.size)
+	}
+
+	_ = s.Set(ctx, "short", resp(), time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if got, _ := s.Get(ctx, "short"); got != nil {
+		t.Error("expired entry returned")
+	}
+
+	_ = s.Set(ctx, "big", &CachedResponse{Body: make([]byte, 400)}, time.Minute)
+	if got, _ := s.Get(ctx, "big"); got != nil {
+		t.Error("entry larger than store was saved")
+	}
+}
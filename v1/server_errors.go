@@ -9,13 +9,15 @@ import "net/http"
 // «Мы что-то сломали». Универсальная внутренняя ошибка, когда
 // причина скрыта от клиента, а разработчики увидят её в логах.
 //
-//   - Используйте при панике, ошибке базы, непредвиденном исключении.
-//   - Не выдавайте детали (stacktrace) публично - только лаконичное msg.
+//   - Используйте при панике (это делает Recover), ошибке базы,
+//     непредвиденном исключении.
+//   - Не выдавайте детали (stacktrace) публично - только лаконичное msg;
+//     причину передавайте в ErrorInternalCause (видна только в Debug).
 //
 // Status: 500 Internal Server Error
 // Code:   INTERNAL
 func ErrorInternal(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusInternalServerError, "INTERNAL", msg, nil)
+	Error(w, r, http.StatusInternalServerError, ErrorCode(http.StatusInternalServerError), msg, nil)
 }
 
 /* 501 */
@@ -35,7 +37,7 @@ func ErrorNotImplemented(w http.ResponseWriter, r *http.Request, feature string)
 	if feature != "" {
 		txt = feature + " not implemented"
 	}
-	Error(w, r, http.StatusNotImplemented, "NOT_IMPLEMENTED", txt, nil)
+	Error(w, r, http.StatusNotImplemented, ErrorCode(http.StatusNotImplemented), txt, nil)
 }
 
 /* 502 */
@@ -50,7 +52,7 @@ func ErrorNotImplemented(w http.ResponseWriter, r *http.Request, feature string)
 // Status: 502 Bad Gateway
 // Code:   BAD_GATEWAY
 func ErrorBadGateway(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusBadGateway, "BAD_GATEWAY", msg, nil)
+	Error(w, r, http.StatusBadGateway, ErrorCode(http.StatusBadGateway), msg, nil)
 }
 
 /* 503 */
@@ -66,7 +68,7 @@ func ErrorBadGateway(w http.ResponseWriter, r *http.Request, msg string) {
 // Status: 503 Service Unavailable
 // Code:   SERVICE_UNAVAILABLE
 func ErrorServiceUnavailable(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", msg, nil)
+	Error(w, r, http.StatusServiceUnavailable, ErrorCode(http.StatusServiceUnavailable), msg, nil)
 }
 
 /* 504 */
@@ -82,7 +84,7 @@ func ErrorServiceUnavailable(w http.ResponseWriter, r *http.Request, msg string)
 // Status: 504 Gateway Timeout
 // Code:   TIMEOUT
 func ErrorTimeout(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusGatewayTimeout, "TIMEOUT", msg, nil)
+	Error(w, r, http.StatusGatewayTimeout, ErrorCode(http.StatusGatewayTimeout), msg, nil)
 }
 
 /* 505 */
@@ -95,7 +97,7 @@ func ErrorTimeout(w http.ResponseWriter, r *http.Request, msg string) {
 // Status: 505 HTTP Version Not Supported
 // Code:   VERSION_NOT_SUPPORTED
 func ErrorHTTPVersionNotSupported(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusHTTPVersionNotSupported, "VERSION_NOT_SUPPORTED", msg, nil)
+	Error(w, r, http.StatusHTTPVersionNotSupported, ErrorCode(http.StatusHTTPVersionNotSupported), msg, nil)
 }
 
 /* 506 */
@@ -110,7 +112,7 @@ func ErrorHTTPVersionNotSupported(w http.ResponseWriter, r *http.Request, msg st
 // Status: 506 Variant Also Negotiates
 // Code:   VARIANT_NEGOTIATES
 func ErrorVariantAlsoNegotiates(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusVariantAlsoNegotiates, "VARIANT_NEGOTIATES", msg, nil)
+	Error(w, r, http.StatusVariantAlsoNegotiates, ErrorCode(http.StatusVariantAlsoNegotiates), msg, nil)
 }
 
 /* 507 */
@@ -125,7 +127,7 @@ func ErrorVariantAlsoNegotiates(w http.ResponseWriter, r *http.Request, msg stri
 // Status: 507 Insufficient Storage
 // Code:   INSUFFICIENT_STORAGE
 func ErrorInsufficientStorage(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusInsufficientStorage, "INSUFFICIENT_STORAGE", msg, nil)
+	Error(w, r, http.StatusInsufficientStorage, ErrorCode(http.StatusInsufficientStorage), msg, nil)
 }
 
 /* 508 */
@@ -138,7 +140,7 @@ func ErrorInsufficientStorage(w http.ResponseWriter, r *http.Request, msg string
 // Status: 508 Loop Detected
 // Code:   LOOP_DETECTED
 func ErrorLoopDetected(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusLoopDetected, "LOOP_DETECTED", msg, nil)
+	Error(w, r, http.StatusLoopDetected, ErrorCode(http.StatusLoopDetected), msg, nil)
 }
 
 /* 510 */
@@ -151,7 +153,7 @@ func ErrorLoopDetected(w http.ResponseWriter, r *http.Request, msg string) {
 // Status: 510 Not Extended
 // Code:   NOT_EXTENDED
 func ErrorNotExtended(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusNotExtended, "NOT_EXTENDED", msg, nil)
+	Error(w, r, http.StatusNotExtended, ErrorCode(http.StatusNotExtended), msg, nil)
 }
 
 /* 511 */
@@ -164,5 +166,5 @@ func ErrorNotExtended(w http.ResponseWriter, r *http.Request, msg string) {
 // Status: 511 Network Authentication Required
 // Code:   NETWORK_AUTH_REQUIRED
 func ErrorNetworkAuthRequired(w http.ResponseWriter, r *http.Request, msg string) {
-	Error(w, r, http.StatusNetworkAuthenticationRequired, "NETWORK_AUTH_REQUIRED", msg, nil)
+	Error(w, r, http.StatusNetworkAuthenticationRequired, ErrorCode(http.StatusNetworkAuthenticationRequired), msg, nil)
 }
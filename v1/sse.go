@@ -0,0 +1,209 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// errStreamingUnsupported - ResponseWriter не умеет Flush (буферизующий прокси-мидлварь и т.п.).
+var errStreamingUnsupported = errors.New("httpx: streaming is not supported by the response writer")
+
+// errSSEClosed - поток уже закрыт (Close, Error или отмена контекста).
+var errSSEClosed = errors.New("httpx: SSE stream is closed")
+
+// SSE - поток Server-Sent Events, где data каждого события - Envelope.
+//
+// Пример:
+//
+//	s, err := httpx.NewSSE(w, r)
+//	if err != nil {
+//	    httpx.ErrorInternal(w, r, err.Error())
+//	    return
+//	}
+//	defer s.Close()
+//
+//	s.Retry(3 * time.Second)
+//	s.Heartbeat(15 * time.Second)
+//
+//	for p := range job.Progress(s.LastEventID()) {
+//	    if err := s.Send("progress", p.ID, p); err != nil {
+//	        return // клиент ушёл
+//	    }
+//	}
+//	if err := job.Err(); err != nil {
+//	    _ = s.Error("JOB_FAILED", err.Error(), nil)
+//	}
+type SSE struct {
+	w  http.ResponseWriter
+	r  *http.Request
+	rc *http.ResponseController
+
+	mu     sync.Mutex
+	closed bool
+	done   chan struct{}
+}
+
+// NewSSE ставит заголовки text/event-stream, отправляет 200 и сбрасывает
+// их клиенту. Ошибка - если ResponseWriter не поддерживает Flush; тогда
+// ничего не отправлено и можно ответить ошибкой.
+func NewSSE(w http.ResponseWriter, r *http.Request) (*SSE, error) {
+	if !canFlush(w) {
+		return nil, errStreamingUnsupported
+	}
+	rc := http.NewResponseController(w)
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	h.Set("X-Accel-Buffering", "no") // nginx: не буферизовать поток
+
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errStreamingUnsupported, err)
+	}
+	_ = rc.SetWriteDeadline(time.Time{}) // поток живёт дольше WriteTimeout сервера
+
+	s := &SSE{w: w, r: r, rc: rc, done: make(chan struct{})}
+	go func() {
+		select {
+		case <-r.Context().Done():
+			s.Close()
+		case <-s.done:
+		}
+	}()
+	return s, nil
+}
+
+// canFlush - w или обёрнутый им writer (Unwrap) реализует http.Flusher;
+// так же ищет http.ResponseController, но без записи заголовков.
+func canFlush(w http.ResponseWriter) bool {
+	for {
+		switch t := w.(type) {
+		case http.Flusher:
+			return true
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = t.Unwrap()
+		default:
+			return false
+		}
+	}
+}
+
+// LastEventID - id последнего полученного события при переподключении
+// (заголовок Last-Event-ID), чтобы продолжить поток с него.
+func (s *SSE) LastEventID() string {
+	return s.r.Header.Get("Last-Event-ID")
+}
+
+// Done закрывается, когда поток закрыт (в т.ч. клиент отключился).
+func (s *SSE) Done() <-chan struct{} {
+	return s.done
+}
+
+// Send отправляет событие: event и id - необязательные, data - Envelope
+// с success=true. Ошибка - если поток закрыт или запись не удалась.
+func (s *SSE) Send(event, id string, data any) error {
+	return s.send(event, id, Envelope{
+		Success: true,
+		Data:    data,
+		TraceID: TraceID(s.r),
+	})
+}
+
+// Error отправляет терминальное событие "error" с ErrorBlock
+// и закрывает поток.
+func (s *SSE) Error(code, message string, details any) error {
+	err := s.send("error", "", Envelope{
+		Success: false,
+		Error: &ErrorBlock{
+			Code:    code,
+			Message: message,
+			Details: details,
+		},
+		TraceID: TraceID(s.r),
+	})
+	s.Close()
+	return err
+}
+
+// Retry сообщает браузеру паузу перед переподключением.
+func (s *SSE) Retry(d time.Duration) error {
+	return s.write(fmt.Sprintf("retry: %d\n\n", d.Milliseconds()))
+}
+
+// Heartbeat раз в interval шлёт комментарий ": ping", чтобы прокси
+// не закрывали простаивающее соединение. Останавливается с закрытием потока.
+func (s *SSE) Heartbeat(interval time.Duration) {
+	go func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if s.write(": ping\n\n") != nil {
+					return
+				}
+			case <-s.done:
+				return
+			}
+		}
+	}()
+}
+
+// Close закрывает поток; повторные вызовы безопасны.
+func (s *SSE) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+}
+
+func (s *SSE) send(event, id string, env Envelope) error {
+	data, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	if id != "" {
+		b.WriteString("id: " + sseField(id) + "\n")
+	}
+	if event != "" {
+		b.WriteString("event: " + sseField(event) + "\n")
+	}
+	b.WriteString("data: ")
+	b.Write(data) // json.Marshal не выдаёт переводов строк
+	b.WriteString("\n\n")
+
+	return s.write(b.String())
+}
+
+// write пишет кадр и сразу сбрасывает его клиенту.
+func (s *SSE) write(frame string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errSSEClosed
+	}
+	if err := s.r.Context().Err(); err != nil {
+		return err
+	}
+	if _, err := s.w.Write([]byte(frame)); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+// sseField убирает переводы строк, которые разорвали бы поле события.
+func sseField(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
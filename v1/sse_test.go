@@ -0,0 +1,174 @@
+package httpx
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// plainWriter - ResponseWriter без Flush (как у буферизующих мидлварей).
+type plainWriter struct {
+	header http.Header
+	status int
+	body   strings.Builder
+}
+
+func (w *plainWriter) Header() http.Header         { return w.header }
+func (w *plainWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
+func (w *plainWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func TestNewSSEUnsupportedWriter(t *testing.T) {
+	w := &plainWriter{header: http.Header{}}
+	r := httptest.NewRequest(http.MethodGet, "/events", nil)
+
+	if _, err := NewSSE(w, r); err == nil {
+		t.Fatal("NewSSE succeeded on a writer without Flush")
+	}
+	ErrorInternal(w, r, "streaming unsupported")
+
+	if w.status != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500", w.status)
+	}
+	if ct := w.header.Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("Content-Type = %q, want application/json", ct)
+	}
+}
+
+func TestSSESend(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s, err := NewSSE(w, r)
+		if err != nil {
+			ErrorInternal(w, r, err.Error())
+			return
+		}
+		defer s.Close()
+		_ = s.Send("progress", "1", map[string]int{"done": 50})
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("status %d, Content-Type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	var lines []string
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() && sc.Text() != "" {
+		lines = append(lines, sc.Text())
+	}
+	want := []string{"id: 1", "event: progress", `data: {"success":true,"data":{"done":50}}`}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("event:\n%s\nwant:\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func newTestSSE(t *testing.T, r *http.Request) (*SSE, *httptest.ResponseRecorder) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	s, err := NewSSE(rec, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, rec
+}
+
+func TestSSERetryAndLastEventID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/events", nil)
+	r.Header.Set("Last-Event-ID", "41")
+	s, rec := newTestSSE(t, r)
+	defer s.Close()
+
+	if id := s.LastEventID(); id != "41" {
+		t.Errorf("LastEventID = %q, want 41", id)
+	}
+	if err := s.Retry(3 * time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if body := rec.Body.String(); body != "retry: 3000\n\n" {
+		t.Errorf("body = %q", body)
+	}
+}
+
+func TestSSEError(t *testing.T) {
+	s, rec := newTestSSE(t, httptest.NewRequest(http.MethodGet, "/events", nil))
+
+	if err := s.Error("JOB_FAILED", "boom", nil); err != nil {
+		t.Fatal(err)
+	}
+	body := rec.Body.String()
+	if !strings.HasPrefix(body, "event: error\ndata: ") || !strings.Contains(body, `"success":false,"error":{"code":"JOB_FAILED","message":"boom"}`) {
+		t.Errorf("body = %q", body)
+	}
+
+	// событие терминальное: поток закрыт, дальше писать нельзя
+	select {
+	case <-s.Done():
+	default:
+		t.Fatal("stream is still open after Error")
+	}
+	if err := s.Send("progress", "", 1); !errors.Is(err, errSSEClosed) {
+		t.Errorf("Send after Error = %v, want errSSEClosed", err)
+	}
+	if rec.Body.String() != body {
+		t.Errorf("body changed after Error: %q", rec.Body.String())
+	}
+}
+
+func TestSSEContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s, _ := newTestSSE(t, httptest.NewRequest(http.MethodGet, "/events", nil).WithContext(ctx))
+
+	cancel()
+	select {
+	case <-s.Done():
+	case <-time.After(time.Second):
+		t.Fatal("stream not closed after request context cancellation")
+	}
+	if err := s.Send("progress", "", 1); err == nil {
+		t.Error("Send succeeded on a cancelled stream")
+	}
+}
+
+func TestSSEHeartbeat(t *testing.T) {
+	closed := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s, err := NewSSE(w, r)
+		if err != nil {
+			ErrorInternal(w, r, err.Error())
+			return
+		}
+		s.Heartbeat(10 * time.Millisecond)
+		<-s.Done() // до отключения клиента
+		close(closed)
+	}))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sc := bufio.NewScanner(resp.Body)
+	if !sc.Scan() || sc.Text() != ": ping" {
+		t.Fatalf("first line = %q, want heartbeat comment", sc.Text())
+	}
+	resp.Body.Close()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("stream not closed after client disconnect")
+	}
+}
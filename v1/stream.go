@@ -0,0 +1,188 @@
+package httpx
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"time"
+)
+
+const (
+	streamFlushItems    = 100         // сбрасываем клиенту каждые N элементов…
+	streamFlushInterval = time.Second // …или не реже, чем раз в интервал
+	streamBufferSize    = 32 << 10    // 32 KiB
+	streamAbortedCode   = "STREAM_ABORTED"
+	streamAbortedMsg    = "Response stream aborted"
+)
+
+// StreamJSON - 200 OK с Envelope, где data - массив, который заполняется
+// из seq по мере чтения: весь результат в памяти не держится.
+//
+// Если seq упал до первого элемента - обычный 500 INTERNAL. Если посреди
+// потока - статус уже отправлен, поэтому массив закрывается и Envelope
+// завершается маркером ошибки:
+//
+//	{"data":[{…},{…}],"success":false,"error":{"code":"STREAM_ABORTED",…}}
+//
+// Возвращает ошибку seq/записи - для логов; ответ к этому моменту уже записан.
+//
+// Пример:
+//
+//	rows := repo.IterOrders(r.Context(), filter) // iter.Seq2[Order, error]
+//	if err := httpx.StreamJSON(w, r, rows); err != nil {
+//	    log.Printf("export: %v", err)
+//	}
+func StreamJSON[T any](w http.ResponseWriter, r *http.Request, seq iter.Seq2[T, error]) error {
+	sw := newStreamWriter(w, r)
+
+	n := 0
+	for item, err := range seq {
+		var b []byte
+		if err == nil {
+			err = r.Context().Err()
+		}
+		if err == nil {
+			b, err = json.Marshal(item)
+		}
+		if err != nil {
+			if n == 0 {
+				ErrorInternalCause(w, r, "Internal server error", err)
+				return err
+			}
+			_ = sw.tail(streamTail{Success: false, Error: abortedBlock(), TraceID: sw.traceID})
+			return err
+		}
+
+		if n == 0 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			sw.buf.WriteString(`{"data":[`)
+		} else {
+			sw.buf.WriteByte(',')
+		}
+		sw.buf.Write(b)
+		n++
+		if err := sw.tick(n); err != nil {
+			return err // клиент ушёл
+		}
+	}
+
+	if n == 0 { // пустой результат - обычный Envelope с []
+		JSON(w, r, http.StatusOK, []T{})
+		return nil
+	}
+	return sw.tail(streamTail{Success: true, TraceID: sw.traceID})
+}
+
+// StreamNDJSON - 200 OK, application/x-ndjson: по строке-Envelope на элемент
+// seq ({"success":true,"data":{…}}). При ошибке посреди потока последней
+// строкой идёт Envelope с ошибкой STREAM_ABORTED.
+//
+// Пример:
+//
+//	_ = httpx.StreamNDJSON(w, r, repo.IterOrders(r.Context(), filter))
+func StreamNDJSON[T any](w http.ResponseWriter, r *http.Request, seq iter.Seq2[T, error]) error {
+	sw := newStreamWriter(w, r)
+
+	n := 0
+	for item, err := range seq {
+		var b []byte
+		if err == nil {
+			err = r.Context().Err()
+		}
+		if err == nil {
+			b, err = json.Marshal(Envelope{Success: true, Data: item, TraceID: sw.traceID})
+		}
+		if err != nil {
+			if n == 0 {
+				ErrorInternalCause(w, r, "Internal server error", err)
+				return err
+			}
+			_ = sw.enc.Encode(Envelope{Success: false, Error: abortedBlock(), TraceID: sw.traceID})
+			_ = sw.flush()
+			return err
+		}
+
+		if n == 0 {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+		}
+		sw.buf.Write(b)
+		sw.buf.WriteByte('\n')
+		n++
+		if err := sw.tick(n); err != nil {
+			return err
+		}
+	}
+
+	if n == 0 {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+	return sw.flush()
+}
+
+// streamTail - поля Envelope, которые известны только в конце потока.
+type streamTail struct {
+	Success bool        `json:"success"`
+	Error   *ErrorBlock `json:"error,omitempty"`
+	TraceID string      `json:"trace_id,omitempty"`
+}
+
+func abortedBlock() *ErrorBlock {
+	return &ErrorBlock{Code: streamAbortedCode, Message: streamAbortedMsg}
+}
+
+// streamWriter - буфер поверх ResponseWriter с периодическим Flush.
+type streamWriter struct {
+	buf       *bufio.Writer
+	enc       *json.Encoder
+	rc        *http.ResponseController
+	traceID   string
+	lastFlush time.Time
+}
+
+func newStreamWriter(w http.ResponseWriter, r *http.Request) *streamWriter {
+	buf := bufio.NewWriterSize(w, streamBufferSize)
+	return &streamWriter{
+		buf:       buf,
+		enc:       json.NewEncoder(buf),
+		rc:        http.NewResponseController(w),
+		traceID:   TraceID(r),
+		lastFlush: time.Now(),
+	}
+}
+
+// tick сбрасывает буфер каждые streamFlushItems элементов или streamFlushInterval.
+func (sw *streamWriter) tick(n int) error {
+	if n%streamFlushItems != 0 && time.Since(sw.lastFlush) < streamFlushInterval {
+		return nil
+	}
+	return sw.flush()
+}
+
+func (sw *streamWriter) flush() error {
+	sw.lastFlush = time.Now()
+	if err := sw.buf.Flush(); err != nil {
+		return err
+	}
+	if err := sw.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+// tail закрывает массив data и дописывает хвост Envelope.
+func (sw *streamWriter) tail(t streamTail) error {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	sw.buf.WriteString("],")
+	sw.buf.Write(b[1:]) // без '{': поля продолжают начатый объект
+	sw.buf.WriteByte('\n')
+	return sw.flush()
+}
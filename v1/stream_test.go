@@ -0,0 +1,133 @@
+package httpx
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"iter"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// items отдаёт n чисел, затем err (если не nil).
+func items(n int, err error) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for i := range n {
+			if !yield(i, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(0, err)
+		}
+	}
+}
+
+type streamEnvelope struct {
+	Success bool            `json:"success"`
+	Data    json.RawMessage `json:"data"`
+	Error   *ErrorBlock     `json:"error"`
+	TraceID string          `json:"trace_id"`
+}
+
+func streamJSON(t *testing.T, seq iter.Seq2[int, error]) (*httptest.ResponseRecorder, streamEnvelope, error) {
+	t.Helper()
+	withTraceSources(t, TraceHeader("X-Request-ID"))
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("X-Request-ID", "trace-1")
+	rec := httptest.NewRecorder()
+	err := StreamJSON(rec, r, seq)
+
+	var env streamEnvelope
+	if decErr := json.Unmarshal(rec.Body.Bytes(), &env); decErr != nil {
+		t.Fatalf("invalid JSON %q: %v", rec.Body.String(), decErr)
+	}
+	return rec, env, err
+}
+
+func TestStreamJSON(t *testing.T) {
+	rec, env, err := streamJSON(t, items(250, nil))
+	if err != nil || rec.Code != http.StatusOK || !env.Success || env.TraceID != "trace-1" {
+		t.Fatalf("err = %v status = %d env = %+v", err, rec.Code, env)
+	}
+	var data []int
+	if err := json.Unmarshal(env.Data, &data); err != nil || len(data) != 250 || data[249] != 249 {
+		t.Errorf("data = %s", env.Data)
+	}
+	if !rec.Flushed {
+		t.Error("stream was not flushed")
+	}
+}
+
+func TestStreamJSONEmpty(t *testing.T) {
+	_, env, err := streamJSON(t, items(0, nil))
+	if err != nil || !env.Success || string(env.Data) != "[]" {
+		t.Errorf("err = %v env = %+v data %s", err, env, env.Data)
+	}
+}
+
+func TestStreamJSONErrors(t *testing.T) {
+	boom := errors.New("boom")
+
+	rec, env, err := streamJSON(t, items(0, boom))
+	if !errors.Is(err, boom) || rec.Code != http.StatusInternalServerError || env.Error.Code != ErrorCode(http.StatusInternalServerError) {
+		t.Errorf("before first item: err = %v status = %d env = %+v", err, rec.Code, env)
+	}
+
+	rec, env, err = streamJSON(t, items(3, boom))
+	if !errors.Is(err, boom) || rec.Code != http.StatusOK {
+		t.Fatalf("mid-stream: err = %v status = %d", err, rec.Code)
+	}
+	if env.Success || env.Error == nil || env.Error.Code != streamAbortedCode || string(env.Data) != "[0,1,2]" {
+		t.Errorf("mid-stream env = %+v data %s", env, env.Data)
+	}
+}
+
+func TestStreamJSONCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	seq := func(yield func(int, error) bool) {
+		yield(1, nil)
+		cancel() // клиент ушёл посреди потока
+		yield(2, nil)
+	}
+
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	if err := StreamJSON(rec, r, seq); !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+	if !strings.Contains(rec.Body.String(), streamAbortedCode) {
+		t.Errorf("body = %s", rec.Body.String())
+	}
+}
+
+func TestStreamNDJSON(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	err := StreamNDJSON(rec, r, items(3, errors.New("boom")))
+	if err == nil || rec.Header().Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("err = %v headers = %v", err, rec.Header())
+	}
+
+	var lines []streamEnvelope
+	sc := bufio.NewScanner(rec.Body)
+	for sc.Scan() {
+		var env streamEnvelope
+		if err := json.Unmarshal(sc.Bytes(), &env); err != nil {
+			t.Fatalf("line %q: %v", sc.Text(), err)
+		}
+		lines = append(lines, env)
+	}
+	if len(lines) != 4 {
+		t.Fatalf("lines = %d, want 3 items + error", len(lines))
+	}
+	if !lines[2].Success || string(lines[2].Data) != "2" {
+		t.Errorf("item line = %+v", lines[2])
+	}
+	if last := lines[3]; last.Success || last.Error == nil || last.Error.Code != streamAbortedCode {
+		t.Errorf("last line = %+v", last)
+	}
+}
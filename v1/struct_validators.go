@@ -0,0 +1,116 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// errNotStruct - правило уровня структуры зарегистрировано не для структуры.
+var errNotStruct = errors.New("httpx: struct validator requires a struct type")
+
+// StructError - нарушение правила уровня структуры, привязанное к полю.
+type StructError struct {
+	Field string // имя поля структуры (Go); в details - как у validator (json при RegisterTagNameFunc)
+	Param string // {1} в переводе; имя соседнего поля заменяется его меткой или именем для клиента
+}
+
+var (
+	structRulesMu sync.Mutex
+	structRules   = map[reflect.Type][]validator.StructLevelFuncCtx{}
+)
+
+// RegisterStructValidator добавляет правило уровня структуры T (сравнение
+// полей, «ровно одно из» и т.п.) + переводы, как RegisterCustomValidator.
+//
+// fn возвращает поля, к которым относится нарушение (одно или несколько);
+// пустой результат - правило выполнено. Для одного типа можно
+// зарегистрировать несколько правил с разными тегами.
+//
+// Пример:
+//
+//	httpx.RegisterStructValidator("after_start", func(d PeriodDTO) []httpx.StructError {
+//	    if d.End.After(d.Start) {
+//	        return nil
+//	    }
+//	    return []httpx.StructError{{Field: "End", Param: "Start"}}
+//	}, map[string]string{
+//	    "en": "{0} must be after {1}",
+//	    "ru": "{0} должно быть позже {1}",
+//	})
+func RegisterStructValidator[T any](tag string, fn func(v T) []StructError, messages map[string]string) error {
+	if V == nil {
+		return errValidatorUnset
+	}
+
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: %s", errNotStruct, typ)
+	}
+
+	rule := func(_ context.Context, sl validator.StructLevel) {
+		cur := sl.Current()
+		for _, se := range fn(cur.Interface().(T)) {
+			var field any
+			if fv := cur.FieldByName(se.Field); fv.IsValid() && fv.CanInterface() {
+				field = fv.Interface()
+			}
+			// details - под именем для клиента (json при RegisterTagNameFunc),
+			// метки полей и Param подставит перевод (см. translateLabeled).
+			sl.ReportError(field, fieldAlias(typ, se.Field), se.Field, tag, se.Param)
+		}
+	}
+
+	// validator хранит одну функцию на тип - собираем правила типа в цепочку.
+	structRulesMu.Lock()
+	rules := append(structRules[typ], rule)
+	structRules[typ] = rules
+	structRulesMu.Unlock()
+
+	V.RegisterStructValidationCtx(func(ctx context.Context, sl validator.StructLevel) {
+		for _, rule := range rules {
+			rule(ctx, sl)
+		}
+	}, reflect.New(typ).Elem().Interface())
+
+	addMessages(tag, messages)
+	return nil
+}
+
+type aliasKey struct {
+	typ   reflect.Type
+	field string
+}
+
+// fieldAliases кеширует fieldAlias: aliasKey -> string.
+var fieldAliases sync.Map
+
+// fieldAlias - имя поля, под которым его показывает validator (функция
+// RegisterTagNameFunc, обычно имя из тега json); без функции - Go-имя.
+// validator функцию не отдаёт, поэтому имя берётся из ошибки на пробной
+// структуре с тем же полем и тегами (правило - под тегом "validate").
+func fieldAlias(typ reflect.Type, name string) string {
+	key := aliasKey{typ, name}
+	if alias, ok := fieldAliases.Load(key); ok {
+		return alias.(string)
+	}
+
+	alias := name
+	if sf, ok := typ.FieldByName(name); ok && sf.IsExported() {
+		probe := reflect.StructOf([]reflect.StructField{{
+			Name: sf.Name,
+			Type: reflect.TypeFor[int](),
+			Tag:  reflect.StructTag(`validate:"required" ` + string(sf.Tag)),
+		}})
+		var ve validator.ValidationErrors
+		if errors.As(V.Struct(reflect.New(probe).Interface()), &ve) && len(ve) == 1 {
+			alias = ve[0].Field()
+		}
+	}
+	fieldAliases.Store(key, alias)
+	return alias
+}
@@ -0,0 +1,126 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+type periodDTO struct {
+	Start time.Time
+	End   time.Time
+	Email string
+	Phone string
+}
+
+func TestRegisterStructValidator(t *testing.T) {
+	err := RegisterStructValidator("test_after_start", func(d periodDTO) []StructError {
+		if d.End.After(d.Start) {
+			return nil
+		}
+		return []StructError{{Field: "End", Param: "Start"}}
+	}, map[string]string{"en": "{0} must be after {1}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = RegisterStructValidator("test_one_contact", func(d periodDTO) []StructError {
+		if (d.Email == "") != (d.Phone == "") {
+			return nil
+		}
+		return []StructError{{Field: "Email"}, {Field: "Phone"}}
+	}, map[string]string{"en": "{0}: exactly one contact is required"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		dto  periodDTO
+		want map[string]string
+	}{
+		{"valid", periodDTO{Start: now, End: now.Add(time.Hour), Email: "a@b.c"}, nil},
+		{"end before start", periodDTO{Start: now, End: now.Add(-time.Hour), Phone: "1"}, map[string]string{
+			"End": "End must be after Start",
+		}},
+		{"both rules", periodDTO{Start: now, End: now}, map[string]string{
+			"End":   "End must be after Start",
+			"Email": "Email: exactly one contact is required",
+			"Phone": "Phone: exactly one contact is required",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.Header.Set("Accept-Language", "en")
+			details, _ := validate(r, &tt.dto)
+			if len(details) != len(tt.want) {
+				t.Fatalf("details = %v, want %v", details, tt.want)
+			}
+			for field, msg := range tt.want {
+				if details[field] != msg {
+					t.Errorf("%s = %q, want %q", field, details[field], msg)
+				}
+			}
+		})
+	}
+}
+
+func TestRegisterStructValidatorNotStruct(t *testing.T) {
+	err := RegisterStructValidator("test_positive", func(n int) []StructError { return nil }, nil)
+	if !errors.Is(err, errNotStruct) {
+		t.Errorf("err = %v, want errNotStruct", err)
+	}
+}
+
+type rangeDTO struct {
+	From     int    `json:"from"`
+	To       int    `json:"to" label:"Upper bound"`
+	Password string `json:"password" label:"Secret"`
+	Confirm  string `json:"confirm" validate:"eqfield=Password"`
+}
+
+func TestStructValidatorNames(t *testing.T) {
+	// details - по json-именам, как у полей с обычными правилами
+	resetLocales(t)
+	localesMu.Lock()
+	V = validator.New()
+	V.RegisterTagNameFunc(func(sf reflect.StructField) string {
+		return strings.Split(sf.Tag.Get("json"), ",")[0]
+	})
+	translators = map[string]ut.Translator{}
+	localesMu.Unlock()
+
+	err := RegisterStructValidator("test_range", func(d rangeDTO) []StructError {
+		if d.To > d.From {
+			return nil
+		}
+		return []StructError{{Field: "To", Param: "From"}}
+	}, map[string]string{"en": "{0} must be greater than {1}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.Header.Set("Accept-Language", "en")
+	details, _ := validate(r, &rangeDTO{From: 2, To: 1, Password: "a", Confirm: "b"})
+	want := map[string]string{
+		"to":      "Upper bound must be greater than from", // Param без метки - имя для клиента
+		"confirm": "confirm must be equal to Secret",       // метка поля из параметра eqfield
+	}
+	if len(details) != len(want) {
+		t.Fatalf("details = %v, want %v", details, want)
+	}
+	for field, msg := range want {
+		if details[field] != msg {
+			t.Errorf("%s = %q, want %q", field, details[field], msg)
+		}
+	}
+}
@@ -99,8 +99,51 @@ func ResetContent(w http.ResponseWriter, r *http.Request) {
 // PartialContent - 206 PARTIAL_CONTENT
 //
 // Ответ на запрос с Range‑заголовком (скачивание куска файла).
+// Для отдачи самих байтов (Content-Range, multipart/byteranges, If-Range)
+// используйте ServeRange.
 //
 // Status: 206 Partial Content
 func PartialContent(w http.ResponseWriter, r *http.Request, data any) {
 	JSON(w, r, http.StatusPartialContent, data)
 }
+
+/* 207 */
+
+// MultiStatus - 207 MULTI_STATUS
+//
+// Ответ пакетной операции, где у элементов разные исходы. Данные -
+// BatchResult: items[] с index, status и data или error (code как у Error*),
+// плюс счётчики succeeded/failed.
+//
+// Если исход у всех элементов общий, статус понижается:
+//   - все с одним статусом → этот статус (200, 201, 404, 409…);
+//   - все успешны, но статусы разные → 200;
+//   - все упали с одним 4xx/5xx → Error с кодом статуса, BatchResult в details.
+//
+// Status: 207 Multi-Status
+//
+// Пример:
+//
+//	httpx.MultiStatus(w, r, &batch)
+func MultiStatus(w http.ResponseWriter, r *http.Request, b *Batch) {
+	res := b.result()
+	status := res.status()
+
+	if status >= http.StatusBadRequest {
+		code, msg := ErrorCode(status), "All batch items failed"
+		if first := res.Items[0].Error; first != nil {
+			code, msg = first.Code, first.Message
+		}
+		for _, it := range res.Items[1:] {
+			if it.Error == nil || it.Error.Code != code {
+				code = ErrorCode(status) // напр. VALIDATION и BAD_REQUEST под одним 400
+			}
+			if it.Error == nil || it.Error.Message != msg {
+				msg = "All batch items failed"
+			}
+		}
+		Error(w, r, status, code, msg, res)
+		return
+	}
+	JSON(w, r, status, res)
+}
@@ -0,0 +1,115 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/middleware"
+)
+
+// TraceIDSource извлекает trace_id из запроса; "" - источник пуст.
+type TraceIDSource func(r *http.Request) string
+
+// TraceIDSources - цепочка источников trace_id для Envelope: берётся первое
+// непустое значение. По умолчанию - только request ID chi (middleware.RequestID).
+//
+// Пример (шлюз передаёт X-Correlation-ID, дальше W3C и chi):
+//
+//	httpx.TraceIDSources = []httpx.TraceIDSource{
+//	    httpx.TraceHeader("X-Correlation-ID"),
+//	    httpx.TraceParent,
+//	    httpx.ChiRequestID,
+//	}
+var TraceIDSources = []TraceIDSource{ChiRequestID}
+
+// TraceID - trace_id запроса по цепочке TraceIDSources.
+func TraceID(r *http.Request) string {
+	for _, src := range TraceIDSources {
+		if id := src(r); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// ChiRequestID - request ID из chi middleware.RequestID.
+func ChiRequestID(r *http.Request) string {
+	return middleware.GetReqID(r.Context())
+}
+
+// TraceParent - trace-id из заголовка W3C traceparent
+// ("00-<32 hex trace-id>-<16 hex parent-id>-<2 hex flags>").
+// Некорректный заголовок и нулевой trace-id игнорируются.
+func TraceParent(r *http.Request) string {
+	parts := strings.Split(strings.TrimSpace(r.Header.Get("traceparent")), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || !isHex(parts[0]) {
+		return ""
+	}
+	if parts[0] == "00" && len(parts) != 4 {
+		return ""
+	}
+	id := parts[1]
+	if len(id) != 32 || !isHex(id) || strings.Trim(id, "0") == "" {
+		return ""
+	}
+	if len(parts[2]) != 16 || !isHex(parts[2]) {
+		return ""
+	}
+	return id
+}
+
+// TraceHeader - источник из заголовка запроса name (X-Correlation-ID,
+// X-Amzn-Trace-Id и т.п.); значение длиннее 128 символов отбрасывается.
+func TraceHeader(name string) TraceIDSource {
+	return func(r *http.Request) string {
+		id := strings.TrimSpace(r.Header.Get(name))
+		if len(id) > 128 {
+			return ""
+		}
+		return id
+	}
+}
+
+// TraceFromContext - источник из контекста запроса, например span context
+// OpenTelemetry (httpx не зависит от OTel SDK):
+//
+//	httpx.TraceFromContext(func(ctx context.Context) string {
+//	    if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+//	        return sc.TraceID().String()
+//	    }
+//	    return ""
+//	})
+func TraceFromContext(fn func(ctx context.Context) string) TraceIDSource {
+	return func(r *http.Request) string {
+		return fn(r.Context())
+	}
+}
+
+// EchoTraceID - middleware: возвращает trace_id клиенту в заголовке
+// ответа header (например, X-Request-ID), чтобы его видели и ответы без
+// Envelope (файлы, 204, 304).
+//
+// Пример:
+//
+//	r.Use(middleware.RequestID, httpx.EchoTraceID("X-Request-ID"))
+func EchoTraceID(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if id := TraceID(r); id != "" {
+				w.Header().Set(header, id)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,81 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withTraceSources подменяет TraceIDSources на время теста.
+func withTraceSources(t *testing.T, sources ...TraceIDSource) {
+	t.Helper()
+	prev := TraceIDSources
+	TraceIDSources = sources
+	t.Cleanup(func() { TraceIDSources = prev })
+}
+
+func TestTraceParent(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{"00-00000000000000000000000000000000-00f067aa0ba902b7-01", ""}, // нулевой trace-id
+		{"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", ""}, // запрещённая версия
+		{"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01", ""}, // только lowercase hex
+		{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-x", ""},
+		{"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-x", "4bf92f3577b34da6a3ce929d0e0e4736"},
+		{"garbage", ""},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("traceparent", tt.header)
+		if got := TraceParent(r); got != tt.want {
+			t.Errorf("TraceParent(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestTraceIDChain(t *testing.T) {
+	withTraceSources(t, TraceHeader("X-Correlation-ID"), TraceParent)
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if got := TraceID(r); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Fatalf("TraceID = %q, want traceparent id", got)
+	}
+
+	r.Header.Set("X-Correlation-ID", "corr-1")
+	if got := TraceID(r); got != "corr-1" {
+		t.Fatalf("TraceID = %q, want first source corr-1", got)
+	}
+}
+
+func TestEchoTraceIDWithResponseCache(t *testing.T) {
+	withTraceSources(t, TraceHeader("X-Correlation-ID"))
+
+	h := EchoTraceID("X-Request-ID")(
+		ResponseCache(ResponseCacheConfig{TTL: time.Minute})(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				JSON(w, r, http.StatusOK, "hello")
+			})))
+
+	for i, id := range []string{"aaa", "bbb"} {
+		r := httptest.NewRequest(http.MethodGet, "/greeting", nil)
+		r.Header.Set("X-Correlation-ID", id)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+
+		if want := []string{"MISS", "HIT"}[i]; rec.Header().Get("X-Cache") != want {
+			t.Fatalf("request %s: X-Cache = %q, want %s", id, rec.Header().Get("X-Cache"), want)
+		}
+		if got := rec.Header().Get("X-Request-ID"); got != id {
+			t.Errorf("request %s: X-Request-ID = %q (X-Cache %s)", id, got, rec.Header().Get("X-Cache"))
+		}
+		if !strings.Contains(rec.Body.String(), `"trace_id":"`+id+`"`) {
+			t.Errorf("request %s: body %s", id, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package httpx
+
+import (
+	"reflect"
+	"time"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// messageTable - таблица переводов одного языка, которую httpx ведёт сам
+// (для языков, где переводов validator нет или они неполные).
+//
+//   - messages - шаблоны по ключу; {0} - имя поля, {1} - параметр правила.
+//     Ключи правил размера (len, min, max, lt, lte, gt, gte) содержат суффикс
+//     типа поля: "min-string", "min-items", "min-number", "lt-datetime".
+//   - formats - локализованные названия форматов для шаблона "format";
+//     если названия нет, берётся техническое из formatNames.
+type messageTable struct {
+	messages map[string]string
+	formats  map[string]string
+}
+
+// messageKeys сопоставляет тег правила ключу в messageTable.messages.
+var messageKeys = map[string]string{
+	"required":                      "required",
+	"required_if":                   "required",
+	"required_unless":               "required",
+	"required_with":                 "required",
+	"required_with_all":             "required",
+	"required_without":              "required",
+	"required_without_all":          "required",
+	"skip_unless":                   "required",
+	"excluded_if":                   "excluded",
+	"excluded_unless":               "excluded",
+	"excluded_with":                 "excluded",
+	"excluded_with_all":             "excluded",
+	"excluded_without":              "excluded",
+	"excluded_without_all":          "excluded",
+	"isdefault":                     "isdefault",
+	"eq":                            "eq",
+	"ne":                            "ne",
+	"eqfield":                       "eqfield",
+	"eqcsfield":                     "eqfield",
+	"nefield":                       "nefield",
+	"necsfield":                     "nefield",
+	"gtfield":                       "gtfield",
+	"gtcsfield":                     "gtfield",
+	"gtefield":                      "gtefield",
+	"gtecsfield":                    "gtefield",
+	"ltfield":                       "ltfield",
+	"ltcsfield":                     "ltfield",
+	"ltefield":                      "ltefield",
+	"ltecsfield":                    "ltefield",
+	"fieldcontains":                 "fieldcontains",
+	"fieldexcludes":                 "fieldexcludes",
+	"contains":                      "contains",
+	"containsany":                   "containsany",
+	"containsrune":                  "containsrune",
+	"excludes":                      "excludes",
+	"excludesall":                   "excludesall",
+	"excludesrune":                  "excludesrune",
+	"startswith":                    "startswith",
+	"endswith":                      "endswith",
+	"startsnotwith":                 "startsnotwith",
+	"endsnotwith":                   "endsnotwith",
+	"oneof":                         "oneof",
+	"oneofci":                       "oneof",
+	"unique":                        "unique",
+	"eq_ignore_case":                "eq_ignore_case",
+	"ne_ignore_case":                "ne_ignore_case",
+	"alpha":                         "alpha",
+	"alphanum":                      "alphanum",
+	"alphaunicode":                  "alphaunicode",
+	"alphanumunicode":               "alphanumunicode",
+	"numeric":                       "numeric",
+	"number":                        "number",
+	"boolean":                       "boolean",
+	"lowercase":                     "lowercase",
+	"uppercase":                     "uppercase",
+	"ascii":                         "ascii",
+	"printascii":                    "printascii",
+	"multibyte":                     "multibyte",
+	"nohtml":                        "nohtml",
+	"datetime":                      "datetime",
+	"postcode_iso3166_alpha2":       "postcode",
+	"postcode_iso3166_alpha2_field": "postcode",
+	"file":                          "file",
+	"dir":                           "dir",
+	"image":                         "image",
+	"validateFn":                    "invalid",
+}
+
+// sizedTags - правила, текст которых зависит от типа поля.
+var sizedTags = []string{"len", "min", "max", "lt", "lte", "gt", "gte"}
+
+// formatNames - технические названия форматов; подставляются в шаблон
+// "format" как {1}, если в таблице языка нет локализованного варианта.
+var formatNames = map[string]string{
+	"base32":                     "Base32",
+	"base64":                     "Base64",
+	"base64rawurl":               "Base64 (raw URL)",
+	"base64url":                  "Base64 (URL)",
+	"bcp47_language_tag":         "BCP 47",
+	"bic":                        "BIC (ISO 9362)",
+	"btc_addr":                   "Bitcoin (P2PKH/P2SH)",
+	"btc_addr_bech32":            "Bitcoin (Bech32)",
+	"cidr":                       "CIDR",
+	"cidrv4":                     "CIDR (IPv4)",
+	"cidrv6":                     "CIDR (IPv6)",
+	"credit_card":                "PAN",
+	"cron":                       "cron",
+	"cve":                        "CVE",
+	"datauri":                    "Data URI",
+	"dns_rfc1035_label":          "DNS (RFC 1035)",
+	"e164":                       "E.164",
+	"ein":                        "EIN",
+	"email":                      "e-mail",
+	"eth_addr":                   "Ethereum",
+	"eth_addr_checksum":          "Ethereum (EIP-55)",
+	"fqdn":                       "FQDN",
+	"hexadecimal":                "HEX",
+	"hexcolor":                   "#RRGGBB",
+	"hostname":                   "hostname (RFC 952)",
+	"hostname_port":              "host:port",
+	"hostname_rfc1123":           "hostname (RFC 1123)",
+	"hsl":                        "hsl()",
+	"hsla":                       "hsla()",
+	"html":                       "HTML",
+	"html_encoded":               "HTML entities",
+	"http_url":                   "HTTP(S) URL",
+	"ip":                         "IP",
+	"ip4_addr":                   "IPv4",
+	"ip6_addr":                   "IPv6",
+	"ip_addr":                    "IP",
+	"ipv4":                       "IPv4",
+	"ipv6":                       "IPv6",
+	"isbn":                       "ISBN",
+	"isbn10":                     "ISBN-10",
+	"isbn13":                     "ISBN-13",
+	"iso3166_1_alpha2":           "ISO 3166-1 alpha-2",
+	"iso3166_1_alpha2_eu":        "ISO 3166-1 alpha-2 (EU)",
+	"iso3166_1_alpha3":           "ISO 3166-1 alpha-3",
+	"iso3166_1_alpha3_eu":        "ISO 3166-1 alpha-3 (EU)",
+	"iso3166_1_alpha_numeric":    "ISO 3166-1 numeric",
+	"iso3166_1_alpha_numeric_eu": "ISO 3166-1 numeric (EU)",
+	"iso3166_2":                  "ISO 3166-2",
+	"iso4217":                    "ISO 4217",
+	"iso4217_numeric":            "ISO 4217 numeric",
+	"issn":                       "ISSN",
+	"json":                       "JSON",
+	"jwt":                        "JWT",
+	"latitude":                   "latitude",
+	"longitude":                  "longitude",
+	"luhn_checksum":              "Luhn",
+	"mac":                        "MAC",
+	"md4":                        "MD4",
+	"md5":                        "MD5",
+	"mongodb":                    "MongoDB ObjectID",
+	"mongodb_connection_string":  "MongoDB URI",
+	"port":                       "port",
+	"rgb":                        "rgb()",
+	"rgba":                       "rgba()",
+	"ripemd128":                  "RIPEMD-128",
+	"ripemd160":                  "RIPEMD-160",
+	"semver":                     "SemVer",
+	"sha256":                     "SHA-256",
+	"sha384":                     "SHA-384",
+	"sha512":                     "SHA-512",
+	"spicedb":                    "SpiceDB",
+	"ssn":                        "SSN",
+	"tcp4_addr":                  "TCP4 host:port",
+	"tcp6_addr":                  "TCP6 host:port",
+	"tcp_addr":                   "TCP host:port",
+	"tiger128":                   "Tiger-128",
+	"tiger160":                   "Tiger-160",
+	"tiger192":                   "Tiger-192",
+	"timezone":                   "IANA TZ",
+	"udp4_addr":                  "UDP4 host:port",
+	"udp6_addr":                  "UDP6 host:port",
+	"udp_addr":                   "UDP host:port",
+	"ulid":                       "ULID",
+	"unix_addr":                  "Unix socket",
+	"uri":                        "URI",
+	"url":                        "URL",
+	"url_encoded":                "URL encoding",
+	"urn_rfc2141":                "URN (RFC 2141)",
+	"uuid":                       "UUID",
+	"uuid3":                      "UUID v3",
+	"uuid3_rfc4122":              "UUID v3 (RFC 4122)",
+	"uuid4":                      "UUID v4",
+	"uuid4_rfc4122":              "UUID v4 (RFC 4122)",
+	"uuid5":                      "UUID v5",
+	"uuid5_rfc4122":              "UUID v5 (RFC 4122)",
+	"uuid_rfc4122":               "UUID (RFC 4122)",
+	"filepath":                   "path",
+	"dirpath":                    "path",
+	"iscolor":                    "color",
+	"country_code":               "ISO 3166-1",
+	"eu_country_code":            "ISO 3166-1 (EU)",
+}
+
+// nohtmlMessages - переводы правила nohtml для языков, чьи таблицы
+// берутся из validator/translations.
+var nohtmlMessages = map[string]string{
+	"en": "{0} must not contain HTML",
+	"ru": "{0} не должно содержать HTML",
+	"de": "{0} darf kein HTML enthalten",
+	"zh": "{0}不能包含HTML",
+	"fr": "{0} ne doit pas contenir de HTML",
+	"es": "{0} no debe contener HTML",
+	"it": "{0} non deve contenere HTML",
+	"pt": "{0} não deve conter HTML",
+	"ja": "{0}にHTMLを含めることはできません",
+	"ko": "{0}에는 HTML을 포함할 수 없습니다",
+}
+
+// keyPrefix отделяет ключи таблиц httpx от тегов, которые пользователь
+// регистрирует через RegisterCustomValidator.
+const keyPrefix = "httpx."
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// register добавляет шаблоны таблицы в переводчик и навешивает переводы
+// на все встроенные теги validator (сигнатура совпадает с
+// RegisterDefaultTranslations из validator/translations).
+func (t messageTable) register(v *validator.Validate, tr ut.Translator) error {
+	for key, msg := range t.messages {
+		if err := tr.Add(keyPrefix+key, msg, true); err != nil {
+			return err
+		}
+	}
+
+	translate := func(key string) validator.TranslationFunc {
+		return func(tr ut.Translator, fe validator.FieldError) string {
+			return t.translate(tr, key, fe.Field(), fe.Param())
+		}
+	}
+
+	for tag, key := range messageKeys {
+		if err := registerTranslationFunc(v, tr, tag, translate(key)); err != nil {
+			return err
+		}
+	}
+
+	for _, tag := range sizedTags {
+		fn := func(tr ut.Translator, fe validator.FieldError) string {
+			return t.translate(tr, sizedKey(tag, fe), fe.Field(), fe.Param())
+		}
+		if err := registerTranslationFunc(v, tr, tag, fn); err != nil {
+			return err
+		}
+	}
+
+	for tag := range formatNames {
+		name := t.formatName(tag)
+		fn := func(tr ut.Translator, fe validator.FieldError) string {
+			return t.translate(tr, "format", fe.Field(), name)
+		}
+		if err := registerTranslationFunc(v, tr, tag, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// translate подставляет параметры в шаблон; при отсутствии ключа
+// возвращает общее «значение некорректно».
+func (t messageTable) translate(tr ut.Translator, key, field, param string) string {
+	if msg, err := tr.T(keyPrefix+key, field, param); err == nil {
+		return msg
+	}
+	msg, _ := tr.T(keyPrefix+"invalid", field, param)
+	return msg
+}
+
+func (t messageTable) formatName(tag string) string {
+	if name, ok := t.formats[tag]; ok {
+		return name
+	}
+	return formatNames[tag]
+}
+
+// sizedKey выбирает вариант шаблона по типу поля:
+// строки - символы, коллекции - элементы, time.Time - дата/время.
+func sizedKey(tag string, fe validator.FieldError) string {
+	switch fe.Kind() {
+	case reflect.String:
+		return sizeAlias(tag) + "-string"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return sizeAlias(tag) + "-items"
+	case reflect.Struct:
+		if fe.Type() == timeType && tag != "len" && tag != "min" && tag != "max" {
+			return tag + "-datetime"
+		}
+	}
+	return sizeAlias(tag) + "-number"
+}
+
+// sizeAlias: lte/gte для чисел, строк и коллекций совпадают с max/min.
+func sizeAlias(tag string) string {
+	switch tag {
+	case "lte":
+		return "max"
+	case "gte":
+		return "min"
+	}
+	return tag
+}
+
+func registerTranslationFunc(v *validator.Validate, tr ut.Translator, tag string, fn validator.TranslationFunc) error {
+	return v.RegisterTranslation(tag, tr,
+		func(ut.Translator) error { return nil },
+		fn,
+	)
+}
+
+// registerMessage регистрирует простой перевод тега: {0} - имя поля, {1} - параметр
+// (или defaultParams[tag], если в теге он не указан).
+func registerMessage(v *validator.Validate, tr ut.Translator, tag, msg string) error {
+	return v.RegisterTranslation(tag, tr,
+		func(ut ut.Translator) error {
+			return ut.Add(tag, msg, true)
+		},
+		func(ut ut.Translator, fe validator.FieldError) string {
+			param := fe.Param()
+			if param == "" {
+				param = defaultParams[tag]
+			}
+			t, _ := ut.T(tag, fe.Field(), param)
+			return t
+		},
+	)
+}
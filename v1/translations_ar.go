@@ -0,0 +1,90 @@
+//go:build !httpx_minimal
+
+package httpx
+
+// arMessages - العربية.
+var arMessages = messageTable{
+	messages: map[string]string{
+		"required":        "{0} حقل مطلوب",
+		"excluded":        "يجب ألا يتم تعيين {0}",
+		"isdefault":       "يجب أن يكون {0} بالقيمة الافتراضية",
+		"len-string":      "يجب أن يكون طول {0} {1} حرفًا",
+		"len-items":       "يجب أن يحتوي {0} على {1} عنصرًا",
+		"len-number":      "يجب أن يكون {0} مساويًا لـ {1}",
+		"min-string":      "يجب أن يكون طول {0} {1} حرفًا على الأقل",
+		"min-items":       "يجب أن يحتوي {0} على {1} عنصرًا على الأقل",
+		"min-number":      "يجب أن يكون {0} {1} أو أكبر",
+		"max-string":      "يجب أن يكون طول {0} {1} حرفًا كحد أقصى",
+		"max-items":       "يجب أن يحتوي {0} على {1} عنصرًا كحد أقصى",
+		"max-number":      "يجب أن يكون {0} {1} أو أقل",
+		"lt-string":       "يجب أن يكون طول {0} أقل من {1} حرفًا",
+		"lt-items":        "يجب أن يحتوي {0} على أقل من {1} عنصرًا",
+		"lt-number":       "يجب أن يكون {0} أقل من {1}",
+		"lt-datetime":     "يجب أن يكون {0} قبل التاريخ والوقت الحاليين",
+		"lte-datetime":    "يجب ألا يكون {0} بعد التاريخ والوقت الحاليين",
+		"gt-string":       "يجب أن يكون طول {0} أكثر من {1} حرفًا",
+		"gt-items":        "يجب أن يحتوي {0} على أكثر من {1} عنصرًا",
+		"gt-number":       "يجب أن يكون {0} أكبر من {1}",
+		"gt-datetime":     "يجب أن يكون {0} بعد التاريخ والوقت الحاليين",
+		"gte-datetime":    "يجب ألا يكون {0} قبل التاريخ والوقت الحاليين",
+		"eq":              "يجب أن يكون {0} مساويًا لـ {1}",
+		"ne":              "يجب ألا يكون {0} مساويًا لـ {1}",
+		"eqfield":         "يجب أن يكون {0} مساويًا لـ {1}",
+		"nefield":         "يجب ألا يكون {0} مساويًا لـ {1}",
+		"gtfield":         "يجب أن يكون {0} أكبر من {1}",
+		"gtefield":        "يجب أن يكون {0} أكبر من أو يساوي {1}",
+		"ltfield":         "يجب أن يكون {0} أقل من {1}",
+		"ltefield":        "يجب أن يكون {0} أقل من أو يساوي {1}",
+		"fieldcontains":   "يجب أن يحتوي {0} على قيمة الحقل {1}",
+		"fieldexcludes":   "يجب ألا يحتوي {0} على قيمة الحقل {1}",
+		"contains":        "يجب أن يحتوي {0} على النص '{1}'",
+		"containsany":     "يجب أن يحتوي {0} على حرف واحد على الأقل من '{1}'",
+		"containsrune":    "يجب أن يحتوي {0} على الحرف '{1}'",
+		"excludes":        "يجب ألا يحتوي {0} على النص '{1}'",
+		"excludesall":     "يجب ألا يحتوي {0} على أي من الأحرف '{1}'",
+		"excludesrune":    "يجب ألا يحتوي {0} على الحرف '{1}'",
+		"startswith":      "يجب أن يبدأ {0} بـ '{1}'",
+		"endswith":        "يجب أن ينتهي {0} بـ '{1}'",
+		"startsnotwith":   "يجب ألا يبدأ {0} بـ '{1}'",
+		"endsnotwith":     "يجب ألا ينتهي {0} بـ '{1}'",
+		"oneof":           "يجب أن يكون {0} واحدًا من [{1}]",
+		"unique":          "يجب أن يحتوي {0} على قيم فريدة",
+		"eq_ignore_case":  "يجب أن يكون {0} مساويًا لـ '{1}' (دون مراعاة حالة الأحرف)",
+		"ne_ignore_case":  "يجب ألا يكون {0} مساويًا لـ '{1}' (دون مراعاة حالة الأحرف)",
+		"alpha":           "يمكن أن يحتوي {0} على أحرف لاتينية فقط",
+		"alphanum":        "يمكن أن يحتوي {0} على أحرف لاتينية وأرقام فقط",
+		"alphaunicode":    "يمكن أن يحتوي {0} على أحرف فقط",
+		"alphanumunicode": "يمكن أن يحتوي {0} على أحرف وأرقام فقط",
+		"numeric":         "يجب أن يكون {0} قيمة رقمية صالحة",
+		"number":          "يجب أن يكون {0} رقمًا صالحًا",
+		"boolean":         "يجب أن يكون {0} قيمة منطقية صالحة",
+		"lowercase":       "يجب أن يكون {0} بأحرف صغيرة",
+		"uppercase":       "يجب أن يكون {0} بأحرف كبيرة",
+		"ascii":           "يمكن أن يحتوي {0} على أحرف ASCII فقط",
+		"printascii":      "يمكن أن يحتوي {0} على أحرف ASCII قابلة للطباعة فقط",
+		"multibyte":       "يجب أن يحتوي {0} على أحرف متعددة البايت",
+		"nohtml":          "يجب ألا يحتوي {0} على HTML",
+		"datetime":        "{0} لا يطابق التنسيق {1}",
+		"postcode":        "يجب أن يكون {0} رمزًا بريديًا صالحًا للدولة {1}",
+		"file":            "يجب أن يكون {0} ملفًا موجودًا",
+		"dir":             "يجب أن يكون {0} مجلدًا موجودًا",
+		"image":           "يجب أن يكون {0} صورة صالحة",
+		"invalid":         "قيمة {0} غير صالحة",
+		"format":          "يجب أن يطابق {0} تنسيق {1}",
+	},
+	formats: map[string]string{
+		"email":           "عنوان بريد إلكتروني",
+		"credit_card":     "رقم بطاقة ائتمان",
+		"latitude":        "خط العرض",
+		"longitude":       "خط الطول",
+		"port":            "رقم المنفذ",
+		"timezone":        "المنطقة الزمنية IANA",
+		"filepath":        "مسار ملف",
+		"dirpath":         "مسار مجلد",
+		"iscolor":         "لون",
+		"country_code":    "رمز الدولة",
+		"eu_country_code": "رمز دولة في الاتحاد الأوروبي",
+		"semver":          "إصدار دلالي",
+		"mac":             "عنوان MAC",
+	},
+}
@@ -0,0 +1,90 @@
+//go:build !httpx_minimal
+
+package httpx
+
+// heMessages - עברית.
+var heMessages = messageTable{
+	messages: map[string]string{
+		"required":        "{0} הוא שדה חובה",
+		"excluded":        "אין למלא את {0}",
+		"isdefault":       "{0} חייב להכיל את ערך ברירת המחדל",
+		"len-string":      "אורך {0} חייב להיות {1} תווים",
+		"len-items":       "{0} חייב להכיל {1} פריטים",
+		"len-number":      "{0} חייב להיות שווה ל-{1}",
+		"min-string":      "אורך {0} חייב להיות לפחות {1} תווים",
+		"min-items":       "{0} חייב להכיל לפחות {1} פריטים",
+		"min-number":      "{0} חייב להיות {1} או יותר",
+		"max-string":      "אורך {0} יכול להיות לכל היותר {1} תווים",
+		"max-items":       "{0} יכול להכיל לכל היותר {1} פריטים",
+		"max-number":      "{0} חייב להיות {1} או פחות",
+		"lt-string":       "אורך {0} חייב להיות פחות מ-{1} תווים",
+		"lt-items":        "{0} חייב להכיל פחות מ-{1} פריטים",
+		"lt-number":       "{0} חייב להיות קטן מ-{1}",
+		"lt-datetime":     "{0} חייב להיות לפני התאריך והשעה הנוכחיים",
+		"lte-datetime":    "{0} אינו יכול להיות אחרי התאריך והשעה הנוכחיים",
+		"gt-string":       "אורך {0} חייב להיות יותר מ-{1} תווים",
+		"gt-items":        "{0} חייב להכיל יותר מ-{1} פריטים",
+		"gt-number":       "{0} חייב להיות גדול מ-{1}",
+		"gt-datetime":     "{0} חייב להיות אחרי התאריך והשעה הנוכחיים",
+		"gte-datetime":    "{0} אינו יכול להיות לפני התאריך והשעה הנוכחיים",
+		"eq":              "{0} חייב להיות שווה ל-{1}",
+		"ne":              "{0} אינו יכול להיות שווה ל-{1}",
+		"eqfield":         "{0} חייב להיות שווה ל-{1}",
+		"nefield":         "{0} אינו יכול להיות שווה ל-{1}",
+		"gtfield":         "{0} חייב להיות גדול מ-{1}",
+		"gtefield":        "{0} חייב להיות גדול או שווה ל-{1}",
+		"ltfield":         "{0} חייב להיות קטן מ-{1}",
+		"ltefield":        "{0} חייב להיות קטן או שווה ל-{1}",
+		"fieldcontains":   "{0} חייב להכיל את הערך של {1}",
+		"fieldexcludes":   "{0} אינו יכול להכיל את הערך של {1}",
+		"contains":        "{0} חייב להכיל את הטקסט '{1}'",
+		"containsany":     "{0} חייב להכיל לפחות אחד מהתווים '{1}'",
+		"containsrune":    "{0} חייב להכיל את התו '{1}'",
+		"excludes":        "{0} אינו יכול להכיל את הטקסט '{1}'",
+		"excludesall":     "{0} אינו יכול להכיל אף אחד מהתווים '{1}'",
+		"excludesrune":    "{0} אינו יכול להכיל את התו '{1}'",
+		"startswith":      "{0} חייב להתחיל ב-'{1}'",
+		"endswith":        "{0} חייב להסתיים ב-'{1}'",
+		"startsnotwith":   "{0} אינו יכול להתחיל ב-'{1}'",
+		"endsnotwith":     "{0} אינו יכול להסתיים ב-'{1}'",
+		"oneof":           "{0} חייב להיות אחד מהערכים [{1}]",
+		"unique":          "{0} חייב להכיל ערכים ייחודיים",
+		"eq_ignore_case":  "{0} חייב להיות שווה ל-'{1}' (ללא תלות ברישיות)",
+		"ne_ignore_case":  "{0} אינו יכול להיות שווה ל-'{1}' (ללא תלות ברישיות)",
+		"alpha":           "{0} יכול להכיל אותיות לטיניות בלבד",
+		"alphanum":        "{0} יכול להכיל אותיות לטיניות וספרות בלבד",
+		"alphaunicode":    "{0} יכול להכיל אותיות בלבד",
+		"alphanumunicode": "{0} יכול להכיל אותיות וספרות בלבד",
+		"numeric":         "{0} חייב להיות ערך מספרי תקין",
+		"number":          "{0} חייב להיות מספר תקין",
+		"boolean":         "{0} חייב להיות ערך בוליאני תקין",
+		"lowercase":       "{0} חייב להיות באותיות קטנות",
+		"uppercase":       "{0} חייב להיות באותיות גדולות",
+		"ascii":           "{0} יכול להכיל תווי ASCII בלבד",
+		"printascii":      "{0} יכול להכיל תווי ASCII הניתנים להדפסה בלבד",
+		"multibyte":       "{0} חייב להכיל תווים מרובי בתים",
+		"nohtml":          "{0} אינו יכול להכיל HTML",
+		"datetime":        "{0} אינו תואם לפורמט {1}",
+		"postcode":        "{0} חייב להיות מיקוד תקין עבור המדינה {1}",
+		"file":            "{0} חייב להיות קובץ קיים",
+		"dir":             "{0} חייב להיות תיקייה קיימת",
+		"image":           "{0} חייב להיות תמונה תקינה",
+		"invalid":         "הערך של {0} אינו תקין",
+		"format":          "{0} חייב להיות בפורמט {1}",
+	},
+	formats: map[string]string{
+		"email":           "כתובת דוא\"ל",
+		"credit_card":     "מספר כרטיס אשראי",
+		"latitude":        "קו רוחב",
+		"longitude":       "קו אורך",
+		"port":            "מספר פורט",
+		"timezone":        "אזור זמן IANA",
+		"filepath":        "נתיב קובץ",
+		"dirpath":         "נתיב תיקייה",
+		"iscolor":         "צבע",
+		"country_code":    "קוד מדינה",
+		"eu_country_code": "קוד מדינה באיחוד האירופי",
+		"semver":          "גרסה סמנטית",
+		"mac":             "כתובת MAC",
+	},
+}
@@ -0,0 +1,90 @@
+//go:build !httpx_minimal
+
+package httpx
+
+// lvMessages - latviešu valoda.
+var lvMessages = messageTable{
+	messages: map[string]string{
+		"required":        "{0} ir obligāts lauks",
+		"excluded":        "{0} nedrīkst būt norādīts",
+		"isdefault":       "{0} jābūt noklusējuma vērtībai",
+		"len-string":      "{0} garumam jābūt tieši {1} rakstzīmes",
+		"len-items":       "{0} jāsatur tieši {1} elementi",
+		"len-number":      "{0} jābūt vienādam ar {1}",
+		"min-string":      "{0} garumam jābūt vismaz {1} rakstzīmes",
+		"min-items":       "{0} jāsatur vismaz {1} elementi",
+		"min-number":      "{0} jābūt {1} vai lielākam",
+		"max-string":      "{0} garums nedrīkst pārsniegt {1} rakstzīmes",
+		"max-items":       "{0} drīkst saturēt ne vairāk kā {1} elementus",
+		"max-number":      "{0} jābūt {1} vai mazākam",
+		"lt-string":       "{0} garumam jābūt mazākam par {1} rakstzīmēm",
+		"lt-items":        "{0} jāsatur mazāk nekā {1} elementi",
+		"lt-number":       "{0} jābūt mazākam par {1}",
+		"lt-datetime":     "{0} jābūt pirms pašreizējā datuma un laika",
+		"lte-datetime":    "{0} nedrīkst būt vēlāks par pašreizējo datumu un laiku",
+		"gt-string":       "{0} garumam jābūt lielākam par {1} rakstzīmēm",
+		"gt-items":        "{0} jāsatur vairāk nekā {1} elementi",
+		"gt-number":       "{0} jābūt lielākam par {1}",
+		"gt-datetime":     "{0} jābūt pēc pašreizējā datuma un laika",
+		"gte-datetime":    "{0} nedrīkst būt agrāks par pašreizējo datumu un laiku",
+		"eq":              "{0} jābūt vienādam ar {1}",
+		"ne":              "{0} nedrīkst būt vienāds ar {1}",
+		"eqfield":         "{0} jābūt vienādam ar {1}",
+		"nefield":         "{0} nedrīkst būt vienāds ar {1}",
+		"gtfield":         "{0} jābūt lielākam par {1}",
+		"gtefield":        "{0} jābūt lielākam vai vienādam ar {1}",
+		"ltfield":         "{0} jābūt mazākam par {1}",
+		"ltefield":        "{0} jābūt mazākam vai vienādam ar {1}",
+		"fieldcontains":   "{0} jāsatur lauka {1} vērtība",
+		"fieldexcludes":   "{0} nedrīkst saturēt lauka {1} vērtību",
+		"contains":        "{0} jāsatur teksts '{1}'",
+		"containsany":     "{0} jāsatur vismaz viena no rakstzīmēm '{1}'",
+		"containsrune":    "{0} jāsatur rakstzīme '{1}'",
+		"excludes":        "{0} nedrīkst saturēt tekstu '{1}'",
+		"excludesall":     "{0} nedrīkst saturēt nevienu no rakstzīmēm '{1}'",
+		"excludesrune":    "{0} nedrīkst saturēt rakstzīmi '{1}'",
+		"startswith":      "{0} jāsākas ar '{1}'",
+		"endswith":        "{0} jābeidzas ar '{1}'",
+		"startsnotwith":   "{0} nedrīkst sākties ar '{1}'",
+		"endsnotwith":     "{0} nedrīkst beigties ar '{1}'",
+		"oneof":           "{0} jābūt vienai no vērtībām [{1}]",
+		"unique":          "{0} jāsatur unikālas vērtības",
+		"eq_ignore_case":  "{0} jābūt vienādam ar '{1}' (reģistrs netiek ņemts vērā)",
+		"ne_ignore_case":  "{0} nedrīkst būt vienāds ar '{1}' (reģistrs netiek ņemts vērā)",
+		"alpha":           "{0} drīkst saturēt tikai latīņu burtus",
+		"alphanum":        "{0} drīkst saturēt tikai latīņu burtus un ciparus",
+		"alphaunicode":    "{0} drīkst saturēt tikai burtus",
+		"alphanumunicode": "{0} drīkst saturēt tikai burtus un ciparus",
+		"numeric":         "{0} jābūt derīgai skaitliskai vērtībai",
+		"number":          "{0} jābūt derīgam skaitlim",
+		"boolean":         "{0} jābūt derīgai Būla vērtībai",
+		"lowercase":       "{0} jābūt rakstītam mazajiem burtiem",
+		"uppercase":       "{0} jābūt rakstītam lielajiem burtiem",
+		"ascii":           "{0} drīkst saturēt tikai ASCII rakstzīmes",
+		"printascii":      "{0} drīkst saturēt tikai drukājamas ASCII rakstzīmes",
+		"multibyte":       "{0} jāsatur daudzbaitu rakstzīmes",
+		"nohtml":          "{0} nedrīkst saturēt HTML",
+		"datetime":        "{0} neatbilst formātam {1}",
+		"postcode":        "{0} jābūt derīgam pasta indeksam valstij {1}",
+		"file":            "{0} jābūt esošam failam",
+		"dir":             "{0} jābūt esošai direktorijai",
+		"image":           "{0} jābūt derīgam attēlam",
+		"invalid":         "{0} vērtība nav derīga",
+		"format":          "{0} jāatbilst formātam {1}",
+	},
+	formats: map[string]string{
+		"email":           "e-pasta adrese",
+		"credit_card":     "kredītkartes numurs",
+		"latitude":        "ģeogrāfiskais platums",
+		"longitude":       "ģeogrāfiskais garums",
+		"port":            "porta numurs",
+		"timezone":        "IANA laika josla",
+		"filepath":        "faila ceļš",
+		"dirpath":         "direktorijas ceļš",
+		"iscolor":         "krāsa",
+		"country_code":    "valsts kods",
+		"eu_country_code": "ES valsts kods",
+		"semver":          "semantiskā versija",
+		"mac":             "MAC adrese",
+	},
+}
@@ -0,0 +1,90 @@
+//go:build !httpx_minimal
+
+package httpx
+
+// nlMessages - Nederlands.
+var nlMessages = messageTable{
+	messages: map[string]string{
+		"required":        "{0} is een verplicht veld",
+		"excluded":        "{0} mag niet worden ingevuld",
+		"isdefault":       "{0} moet de standaardwaarde hebben",
+		"len-string":      "{0} moet {1} tekens lang zijn",
+		"len-items":       "{0} moet {1} items bevatten",
+		"len-number":      "{0} moet gelijk zijn aan {1}",
+		"min-string":      "{0} moet minimaal {1} tekens lang zijn",
+		"min-items":       "{0} moet minimaal {1} items bevatten",
+		"min-number":      "{0} moet {1} of groter zijn",
+		"max-string":      "{0} mag maximaal {1} tekens lang zijn",
+		"max-items":       "{0} mag maximaal {1} items bevatten",
+		"max-number":      "{0} moet {1} of kleiner zijn",
+		"lt-string":       "{0} moet korter zijn dan {1} tekens",
+		"lt-items":        "{0} moet minder dan {1} items bevatten",
+		"lt-number":       "{0} moet kleiner zijn dan {1}",
+		"lt-datetime":     "{0} moet vóór de huidige datum en tijd liggen",
+		"lte-datetime":    "{0} mag niet na de huidige datum en tijd liggen",
+		"gt-string":       "{0} moet langer zijn dan {1} tekens",
+		"gt-items":        "{0} moet meer dan {1} items bevatten",
+		"gt-number":       "{0} moet groter zijn dan {1}",
+		"gt-datetime":     "{0} moet na de huidige datum en tijd liggen",
+		"gte-datetime":    "{0} mag niet vóór de huidige datum en tijd liggen",
+		"eq":              "{0} moet gelijk zijn aan {1}",
+		"ne":              "{0} mag niet gelijk zijn aan {1}",
+		"eqfield":         "{0} moet gelijk zijn aan {1}",
+		"nefield":         "{0} mag niet gelijk zijn aan {1}",
+		"gtfield":         "{0} moet groter zijn dan {1}",
+		"gtefield":        "{0} moet groter dan of gelijk aan {1} zijn",
+		"ltfield":         "{0} moet kleiner zijn dan {1}",
+		"ltefield":        "{0} moet kleiner dan of gelijk aan {1} zijn",
+		"fieldcontains":   "{0} moet de waarde van {1} bevatten",
+		"fieldexcludes":   "{0} mag de waarde van {1} niet bevatten",
+		"contains":        "{0} moet de tekst '{1}' bevatten",
+		"containsany":     "{0} moet minimaal één van de tekens '{1}' bevatten",
+		"containsrune":    "{0} moet het teken '{1}' bevatten",
+		"excludes":        "{0} mag de tekst '{1}' niet bevatten",
+		"excludesall":     "{0} mag geen van de tekens '{1}' bevatten",
+		"excludesrune":    "{0} mag het teken '{1}' niet bevatten",
+		"startswith":      "{0} moet beginnen met '{1}'",
+		"endswith":        "{0} moet eindigen op '{1}'",
+		"startsnotwith":   "{0} mag niet beginnen met '{1}'",
+		"endsnotwith":     "{0} mag niet eindigen op '{1}'",
+		"oneof":           "{0} moet een van de volgende zijn: [{1}]",
+		"unique":          "{0} moet unieke waarden bevatten",
+		"eq_ignore_case":  "{0} moet gelijk zijn aan '{1}' (hoofdletterongevoelig)",
+		"ne_ignore_case":  "{0} mag niet gelijk zijn aan '{1}' (hoofdletterongevoelig)",
+		"alpha":           "{0} mag alleen Latijnse letters bevatten",
+		"alphanum":        "{0} mag alleen Latijnse letters en cijfers bevatten",
+		"alphaunicode":    "{0} mag alleen letters bevatten",
+		"alphanumunicode": "{0} mag alleen letters en cijfers bevatten",
+		"numeric":         "{0} moet een geldige numerieke waarde zijn",
+		"number":          "{0} moet een geldig getal zijn",
+		"boolean":         "{0} moet een geldige booleaanse waarde zijn",
+		"lowercase":       "{0} moet in kleine letters zijn",
+		"uppercase":       "{0} moet in hoofdletters zijn",
+		"ascii":           "{0} mag alleen ASCII-tekens bevatten",
+		"printascii":      "{0} mag alleen afdrukbare ASCII-tekens bevatten",
+		"multibyte":       "{0} moet multibyte-tekens bevatten",
+		"nohtml":          "{0} mag geen HTML bevatten",
+		"datetime":        "{0} komt niet overeen met het formaat {1}",
+		"postcode":        "{0} moet een geldige postcode voor land {1} zijn",
+		"file":            "{0} moet een bestaand bestand zijn",
+		"dir":             "{0} moet een bestaande map zijn",
+		"image":           "{0} moet een geldige afbeelding zijn",
+		"invalid":         "{0} is ongeldig",
+		"format":          "{0} moet voldoen aan het formaat {1}",
+	},
+	formats: map[string]string{
+		"email":           "e-mailadres",
+		"credit_card":     "creditcardnummer",
+		"latitude":        "breedtegraad",
+		"longitude":       "lengtegraad",
+		"port":            "poortnummer",
+		"timezone":        "IANA-tijdzone",
+		"filepath":        "bestandspad",
+		"dirpath":         "mappad",
+		"iscolor":         "kleur",
+		"country_code":    "landcode",
+		"eu_country_code": "EU-landcode",
+		"semver":          "semantische versie",
+		"mac":             "MAC-adres",
+	},
+}
@@ -0,0 +1,90 @@
+//go:build !httpx_minimal
+
+package httpx
+
+// plMessages - język polski.
+var plMessages = messageTable{
+	messages: map[string]string{
+		"required":        "{0} jest polem wymaganym",
+		"excluded":        "{0} nie może być ustawione",
+		"isdefault":       "{0} musi mieć wartość domyślną",
+		"len-string":      "{0} musi mieć długość {1} znaków",
+		"len-items":       "{0} musi zawierać {1} elementów",
+		"len-number":      "{0} musi być równe {1}",
+		"min-string":      "{0} musi mieć co najmniej {1} znaków",
+		"min-items":       "{0} musi zawierać co najmniej {1} elementów",
+		"min-number":      "{0} musi wynosić co najmniej {1}",
+		"max-string":      "{0} może mieć maksymalnie {1} znaków",
+		"max-items":       "{0} może zawierać maksymalnie {1} elementów",
+		"max-number":      "{0} może wynosić maksymalnie {1}",
+		"lt-string":       "{0} musi mieć mniej niż {1} znaków",
+		"lt-items":        "{0} musi zawierać mniej niż {1} elementów",
+		"lt-number":       "{0} musi być mniejsze niż {1}",
+		"lt-datetime":     "{0} musi być wcześniejsze niż bieżąca data i godzina",
+		"lte-datetime":    "{0} nie może być późniejsze niż bieżąca data i godzina",
+		"gt-string":       "{0} musi mieć więcej niż {1} znaków",
+		"gt-items":        "{0} musi zawierać więcej niż {1} elementów",
+		"gt-number":       "{0} musi być większe niż {1}",
+		"gt-datetime":     "{0} musi być późniejsze niż bieżąca data i godzina",
+		"gte-datetime":    "{0} nie może być wcześniejsze niż bieżąca data i godzina",
+		"eq":              "{0} musi być równe {1}",
+		"ne":              "{0} nie może być równe {1}",
+		"eqfield":         "{0} musi być równe {1}",
+		"nefield":         "{0} nie może być równe {1}",
+		"gtfield":         "{0} musi być większe niż {1}",
+		"gtefield":        "{0} musi być większe lub równe {1}",
+		"ltfield":         "{0} musi być mniejsze niż {1}",
+		"ltefield":        "{0} musi być mniejsze lub równe {1}",
+		"fieldcontains":   "{0} musi zawierać wartość pola {1}",
+		"fieldexcludes":   "{0} nie może zawierać wartości pola {1}",
+		"contains":        "{0} musi zawierać tekst '{1}'",
+		"containsany":     "{0} musi zawierać co najmniej jeden ze znaków '{1}'",
+		"containsrune":    "{0} musi zawierać znak '{1}'",
+		"excludes":        "{0} nie może zawierać tekstu '{1}'",
+		"excludesall":     "{0} nie może zawierać żadnego ze znaków '{1}'",
+		"excludesrune":    "{0} nie może zawierać znaku '{1}'",
+		"startswith":      "{0} musi zaczynać się od '{1}'",
+		"endswith":        "{0} musi kończyć się na '{1}'",
+		"startsnotwith":   "{0} nie może zaczynać się od '{1}'",
+		"endsnotwith":     "{0} nie może kończyć się na '{1}'",
+		"oneof":           "{0} musi być jedną z wartości [{1}]",
+		"unique":          "{0} musi zawierać unikalne wartości",
+		"eq_ignore_case":  "{0} musi być równe '{1}' (bez rozróżniania wielkości liter)",
+		"ne_ignore_case":  "{0} nie może być równe '{1}' (bez rozróżniania wielkości liter)",
+		"alpha":           "{0} może zawierać tylko litery łacińskie",
+		"alphanum":        "{0} może zawierać tylko litery łacińskie i cyfry",
+		"alphaunicode":    "{0} może zawierać tylko litery",
+		"alphanumunicode": "{0} może zawierać tylko litery i cyfry",
+		"numeric":         "{0} musi być wartością liczbową",
+		"number":          "{0} musi być liczbą",
+		"boolean":         "{0} musi być wartością logiczną",
+		"lowercase":       "{0} musi być zapisane małymi literami",
+		"uppercase":       "{0} musi być zapisane wielkimi literami",
+		"ascii":           "{0} może zawierać tylko znaki ASCII",
+		"printascii":      "{0} może zawierać tylko drukowalne znaki ASCII",
+		"multibyte":       "{0} musi zawierać znaki wielobajtowe",
+		"nohtml":          "{0} nie może zawierać HTML",
+		"datetime":        "{0} nie jest zgodne z formatem {1}",
+		"postcode":        "{0} musi być prawidłowym kodem pocztowym kraju {1}",
+		"file":            "{0} musi być istniejącym plikiem",
+		"dir":             "{0} musi być istniejącym katalogiem",
+		"image":           "{0} musi być prawidłowym obrazem",
+		"invalid":         "{0} ma nieprawidłową wartość",
+		"format":          "{0} musi być zgodne z formatem {1}",
+	},
+	formats: map[string]string{
+		"email":           "adres e-mail",
+		"credit_card":     "numer karty płatniczej",
+		"latitude":        "szerokość geograficzna",
+		"longitude":       "długość geograficzna",
+		"port":            "numer portu",
+		"timezone":        "strefa czasowa IANA",
+		"filepath":        "ścieżka pliku",
+		"dirpath":         "ścieżka katalogu",
+		"iscolor":         "kolor",
+		"country_code":    "kod kraju",
+		"eu_country_code": "kod kraju UE",
+		"semver":          "wersja semantyczna",
+		"mac":             "adres MAC",
+	},
+}
@@ -0,0 +1,102 @@
+//go:build !httpx_minimal
+
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// httpxTables - языки, переводы которых ведёт httpx (translations_*.go).
+var httpxTables = map[string]messageTable{
+	"lv": lvMessages,
+	"uk": ukMessages,
+	"pl": plMessages,
+	"tr": trMessages,
+	"ar": arMessages,
+	"he": heMessages,
+	"nl": nlMessages,
+}
+
+// requiredMessageKeys - все ключи, к которым обращается messageTable.register.
+func requiredMessageKeys() map[string]bool {
+	keys := map[string]bool{"format": true, "invalid": true}
+	for _, key := range messageKeys {
+		keys[key] = true
+	}
+	for _, tag := range sizedTags {
+		for _, kind := range []string{"string", "items", "number"} {
+			keys[sizeAlias(tag)+"-"+kind] = true
+		}
+		if tag != "len" && tag != "min" && tag != "max" {
+			keys[tag+"-datetime"] = true
+		}
+	}
+	return keys
+}
+
+func TestMessageTablesComplete(t *testing.T) {
+	required := requiredMessageKeys()
+	for code, table := range httpxTables {
+		var missing, unused []string
+		for key := range required {
+			if table.messages[key] == "" {
+				missing = append(missing, key)
+			}
+		}
+		for key, msg := range table.messages {
+			if !required[key] {
+				unused = append(unused, key)
+			}
+			if !strings.Contains(msg, "{0}") {
+				t.Errorf("%s: %q has no {0}", code, key)
+			}
+		}
+		for tag := range table.formats {
+			if _, ok := formatNames[tag]; !ok {
+				unused = append(unused, "formats."+tag)
+			}
+		}
+		sort.Strings(missing)
+		sort.Strings(unused)
+		if len(missing) > 0 {
+			t.Errorf("%s: missing %v", code, missing)
+		}
+		if len(unused) > 0 {
+			t.Errorf("%s: unused %v", code, unused)
+		}
+	}
+}
+
+func TestMessageTablesTranslate(t *testing.T) {
+	type dto struct {
+		Name  string    `validate:"required"`
+		Code  string    `validate:"min=3"`
+		Tags  []string  `validate:"max=1"`
+		Age   int       `validate:"gte=18"`
+		Email string    `validate:"email"`
+		When  time.Time `validate:"gt"`
+	}
+	d := dto{Code: "a", Tags: []string{"x", "y"}, Age: 1, Email: "nope"}
+
+	for code := range httpxTables {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.Header.Set("X-Request-Lang", code)
+		details, err := validate(r, &d)
+		if err == nil || len(details) != 6 {
+			t.Fatalf("%s: details = %v", code, details)
+		}
+		for field, msg := range details {
+			if !strings.Contains(msg, field) || strings.Contains(msg, "Error:Field validation") || strings.Contains(msg, "{") {
+				t.Errorf("%s: %s = %q", code, field, msg)
+			}
+		}
+		if !strings.Contains(details["Code"], "3") {
+			t.Errorf("%s: Code = %q, want parameter 3", code, details["Code"])
+		}
+	}
+}
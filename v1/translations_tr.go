@@ -0,0 +1,90 @@
+//go:build !httpx_minimal
+
+package httpx
+
+// trMessages - Türkçe.
+var trMessages = messageTable{
+	messages: map[string]string{
+		"required":        "{0} zorunlu bir alandır",
+		"excluded":        "{0} belirtilmemelidir",
+		"isdefault":       "{0} varsayılan değer olmalıdır",
+		"len-string":      "{0} uzunluğu {1} karakter olmalıdır",
+		"len-items":       "{0} {1} öğe içermelidir",
+		"len-number":      "{0}, {1} değerine eşit olmalıdır",
+		"min-string":      "{0} en az {1} karakter uzunluğunda olmalıdır",
+		"min-items":       "{0} en az {1} öğe içermelidir",
+		"min-number":      "{0} en az {1} olmalıdır",
+		"max-string":      "{0} en fazla {1} karakter uzunluğunda olmalıdır",
+		"max-items":       "{0} en fazla {1} öğe içermelidir",
+		"max-number":      "{0} en fazla {1} olmalıdır",
+		"lt-string":       "{0} uzunluğu {1} karakterden az olmalıdır",
+		"lt-items":        "{0} {1} öğeden az içermelidir",
+		"lt-number":       "{0}, {1} değerinden küçük olmalıdır",
+		"lt-datetime":     "{0} şu anki tarih ve saatten önce olmalıdır",
+		"lte-datetime":    "{0} şu anki tarih ve saatten sonra olmamalıdır",
+		"gt-string":       "{0} uzunluğu {1} karakterden fazla olmalıdır",
+		"gt-items":        "{0} {1} öğeden fazla içermelidir",
+		"gt-number":       "{0}, {1} değerinden büyük olmalıdır",
+		"gt-datetime":     "{0} şu anki tarih ve saatten sonra olmalıdır",
+		"gte-datetime":    "{0} şu anki tarih ve saatten önce olmamalıdır",
+		"eq":              "{0}, {1} değerine eşit olmalıdır",
+		"ne":              "{0}, {1} değerine eşit olmamalıdır",
+		"eqfield":         "{0}, {1} ile eşit olmalıdır",
+		"nefield":         "{0}, {1} ile eşit olmamalıdır",
+		"gtfield":         "{0}, {1} alanından büyük olmalıdır",
+		"gtefield":        "{0}, {1} alanından büyük veya ona eşit olmalıdır",
+		"ltfield":         "{0}, {1} alanından küçük olmalıdır",
+		"ltefield":        "{0}, {1} alanından küçük veya ona eşit olmalıdır",
+		"fieldcontains":   "{0}, {1} alanının değerini içermelidir",
+		"fieldexcludes":   "{0}, {1} alanının değerini içermemelidir",
+		"contains":        "{0} '{1}' metnini içermelidir",
+		"containsany":     "{0} '{1}' karakterlerinden en az birini içermelidir",
+		"containsrune":    "{0} '{1}' karakterini içermelidir",
+		"excludes":        "{0} '{1}' metnini içermemelidir",
+		"excludesall":     "{0} '{1}' karakterlerinden hiçbirini içermemelidir",
+		"excludesrune":    "{0} '{1}' karakterini içermemelidir",
+		"startswith":      "{0} '{1}' ile başlamalıdır",
+		"endswith":        "{0} '{1}' ile bitmelidir",
+		"startsnotwith":   "{0} '{1}' ile başlamamalıdır",
+		"endsnotwith":     "{0} '{1}' ile bitmemelidir",
+		"oneof":           "{0} şunlardan biri olmalıdır: [{1}]",
+		"unique":          "{0} benzersiz değerler içermelidir",
+		"eq_ignore_case":  "{0} '{1}' değerine eşit olmalıdır (büyük/küçük harf duyarsız)",
+		"ne_ignore_case":  "{0} '{1}' değerine eşit olmamalıdır (büyük/küçük harf duyarsız)",
+		"alpha":           "{0} yalnızca Latin harfleri içerebilir",
+		"alphanum":        "{0} yalnızca Latin harfleri ve rakamlar içerebilir",
+		"alphaunicode":    "{0} yalnızca harf içerebilir",
+		"alphanumunicode": "{0} yalnızca harf ve rakam içerebilir",
+		"numeric":         "{0} geçerli bir sayısal değer olmalıdır",
+		"number":          "{0} geçerli bir sayı olmalıdır",
+		"boolean":         "{0} geçerli bir mantıksal değer olmalıdır",
+		"lowercase":       "{0} küçük harflerle yazılmalıdır",
+		"uppercase":       "{0} büyük harflerle yazılmalıdır",
+		"ascii":           "{0} yalnızca ASCII karakterleri içerebilir",
+		"printascii":      "{0} yalnızca yazdırılabilir ASCII karakterleri içerebilir",
+		"multibyte":       "{0} çok baytlı karakterler içermelidir",
+		"nohtml":          "{0} HTML içeremez",
+		"datetime":        "{0} {1} biçimine uymuyor",
+		"postcode":        "{0}, {1} ülkesi için geçerli bir posta kodu olmalıdır",
+		"file":            "{0} mevcut bir dosya olmalıdır",
+		"dir":             "{0} mevcut bir dizin olmalıdır",
+		"image":           "{0} geçerli bir görsel olmalıdır",
+		"invalid":         "{0} geçersiz",
+		"format":          "{0} geçerli bir {1} olmalıdır",
+	},
+	formats: map[string]string{
+		"email":           "e-posta adresi",
+		"credit_card":     "kredi kartı numarası",
+		"latitude":        "enlem",
+		"longitude":       "boylam",
+		"port":            "port numarası",
+		"timezone":        "IANA saat dilimi",
+		"filepath":        "dosya yolu",
+		"dirpath":         "dizin yolu",
+		"iscolor":         "renk",
+		"country_code":    "ülke kodu",
+		"eu_country_code": "AB ülke kodu",
+		"semver":          "anlamsal sürüm",
+		"mac":             "MAC adresi",
+	},
+}
@@ -0,0 +1,90 @@
+//go:build !httpx_minimal
+
+package httpx
+
+// ukMessages - українська мова.
+var ukMessages = messageTable{
+	messages: map[string]string{
+		"required":        "{0} є обов'язковим полем",
+		"excluded":        "{0} не має бути вказано",
+		"isdefault":       "{0} має мати значення за замовчуванням",
+		"len-string":      "Довжина {0} має становити {1} символів",
+		"len-items":       "{0} має містити {1} елементів",
+		"len-number":      "{0} має дорівнювати {1}",
+		"min-string":      "Довжина {0} має бути не менше {1} символів",
+		"min-items":       "{0} має містити щонайменше {1} елементів",
+		"min-number":      "{0} має бути не менше {1}",
+		"max-string":      "Довжина {0} має бути не більше {1} символів",
+		"max-items":       "{0} має містити не більше {1} елементів",
+		"max-number":      "{0} має бути не більше {1}",
+		"lt-string":       "Довжина {0} має бути менше {1} символів",
+		"lt-items":        "{0} має містити менше {1} елементів",
+		"lt-number":       "{0} має бути менше {1}",
+		"lt-datetime":     "{0} має бути раніше поточної дати й часу",
+		"lte-datetime":    "{0} має бути не пізніше поточної дати й часу",
+		"gt-string":       "Довжина {0} має бути більше {1} символів",
+		"gt-items":        "{0} має містити більше {1} елементів",
+		"gt-number":       "{0} має бути більше {1}",
+		"gt-datetime":     "{0} має бути пізніше поточної дати й часу",
+		"gte-datetime":    "{0} має бути не раніше поточної дати й часу",
+		"eq":              "{0} має дорівнювати {1}",
+		"ne":              "{0} не має дорівнювати {1}",
+		"eqfield":         "{0} має дорівнювати {1}",
+		"nefield":         "{0} не має дорівнювати {1}",
+		"gtfield":         "{0} має бути більше {1}",
+		"gtefield":        "{0} має бути більше або дорівнювати {1}",
+		"ltfield":         "{0} має бути менше {1}",
+		"ltefield":        "{0} має бути менше або дорівнювати {1}",
+		"fieldcontains":   "{0} має містити значення поля {1}",
+		"fieldexcludes":   "{0} не має містити значення поля {1}",
+		"contains":        "{0} має містити текст '{1}'",
+		"containsany":     "{0} має містити хоча б один із символів '{1}'",
+		"containsrune":    "{0} має містити символ '{1}'",
+		"excludes":        "{0} не має містити текст '{1}'",
+		"excludesall":     "{0} не має містити жодного із символів '{1}'",
+		"excludesrune":    "{0} не має містити символ '{1}'",
+		"startswith":      "{0} має починатися з '{1}'",
+		"endswith":        "{0} має закінчуватися на '{1}'",
+		"startsnotwith":   "{0} не має починатися з '{1}'",
+		"endsnotwith":     "{0} не має закінчуватися на '{1}'",
+		"oneof":           "{0} має бути одним із [{1}]",
+		"unique":          "{0} має містити унікальні значення",
+		"eq_ignore_case":  "{0} має дорівнювати '{1}' (без урахування регістру)",
+		"ne_ignore_case":  "{0} не має дорівнювати '{1}' (без урахування регістру)",
+		"alpha":           "{0} може містити лише латинські літери",
+		"alphanum":        "{0} може містити лише латинські літери та цифри",
+		"alphaunicode":    "{0} може містити лише літери",
+		"alphanumunicode": "{0} може містити лише літери та цифри",
+		"numeric":         "{0} має бути числовим значенням",
+		"number":          "{0} має бути числом",
+		"boolean":         "{0} має бути логічним значенням",
+		"lowercase":       "{0} має бути в нижньому регістрі",
+		"uppercase":       "{0} має бути у верхньому регістрі",
+		"ascii":           "{0} може містити лише символи ASCII",
+		"printascii":      "{0} може містити лише друковані символи ASCII",
+		"multibyte":       "{0} має містити багатобайтові символи",
+		"nohtml":          "{0} не може містити HTML",
+		"datetime":        "{0} не відповідає формату {1}",
+		"postcode":        "{0} має бути коректним поштовим індексом країни {1}",
+		"file":            "{0} має бути наявним файлом",
+		"dir":             "{0} має бути наявним каталогом",
+		"image":           "{0} має бути коректним зображенням",
+		"invalid":         "{0} має некоректне значення",
+		"format":          "{0} має відповідати формату {1}",
+	},
+	formats: map[string]string{
+		"email":           "адреса електронної пошти",
+		"credit_card":     "номер банківської картки",
+		"latitude":        "широта",
+		"longitude":       "довгота",
+		"port":            "номер порту",
+		"timezone":        "часовий пояс IANA",
+		"filepath":        "шлях до файлу",
+		"dirpath":         "шлях до каталогу",
+		"iscolor":         "колір",
+		"country_code":    "код країни",
+		"eu_country_code": "код країни ЄС",
+		"semver":          "семантична версія",
+		"mac":             "MAC-адреса",
+	},
+}
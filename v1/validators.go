@@ -0,0 +1,209 @@
+package httpx
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// errUnknownValidator - в каталоге httpx нет правила с таким тегом.
+var errUnknownValidator = errors.New("httpx: unknown built-in validator")
+
+// errBadValidatorParam - параметр правила в теге validate не разбирается.
+var errBadValidatorParam = errors.New("httpx: invalid validator parameter")
+
+// bundledValidator - правило из каталога httpx + переводы для всех языков.
+// alias - встроенное правило validator, под которым регистрируется тег
+// (своей реализации у тега тогда нет).
+type bundledValidator struct {
+	fn       validator.Func
+	alias    string
+	messages map[string]string
+}
+
+// passwordMinLen - минимальная длина пароля, если у правила нет параметра.
+const passwordMinLen = 8
+
+// defaultParams - параметр правила по умолчанию, подставляется в перевод как {1}.
+var defaultParams = map[string]string{
+	"password": strconv.Itoa(passwordMinLen),
+}
+
+// RegisterValidators подключает готовые правила httpx (по умолчанию они
+// выключены). Без аргументов - все правила каталога:
+//
+//	tz        - IANA time zone (Europe/Riga), псевдоним timezone
+//	phone     - телефон в формате E.164 (+37120000000), псевдоним e164
+//	iban      - IBAN с проверкой длины страны и контрольной суммы
+//	swift     - BIC/SWIFT (ISO 9362), псевдоним bic
+//	slug      - url-slug: a-z, 0-9 и одиночные дефисы
+//	version   - семантическая версия (SemVer 2.0), псевдоним semver
+//	password  - пароль: строчные, заглавные, цифры, спецсимволы;
+//	            параметр - минимальная длина (password=12), по умолчанию 8;
+//	            нечисловой параметр - паника, как у min/max
+//	country   - код страны ISO 3166-1 alpha-2 (LV), псевдоним iso3166_1_alpha2
+//	currency  - код валюты ISO 4217 (EUR), псевдоним iso4217
+//	filename  - безопасное имя файла (без путей, управляющих и зарезервированных имён)
+//	nocontrol - без управляющих символов (кроме \t, \n, \r)
+//
+// Повторный вызов безопасен: правила и переводы перерегистрируются.
+//
+// Пример:
+//
+//	_ = httpx.RegisterValidators("tz", "phone")
+//
+//	type ProfileDTO struct {
+//	    TZ    string `json:"tz" validate:"required,tz"`
+//	    Phone string `json:"phone" validate:"omitempty,phone"`
+//	}
+func RegisterValidators(tags ...string) error {
+	if len(tags) == 0 {
+		for tag := range bundledValidators {
+			tags = append(tags, tag)
+		}
+	}
+
+	for _, tag := range tags {
+		bv, ok := bundledValidators[tag]
+		if !ok {
+			return fmt.Errorf("%w: %q", errUnknownValidator, tag)
+		}
+		if bv.alias == "" {
+			if err := RegisterCustomValidator(tag, bv.fn, bv.messages); err != nil {
+				return err
+			}
+			continue
+		}
+		if V == nil {
+			return errValidatorUnset
+		}
+		V.RegisterAlias(tag, bv.alias)
+		addMessages(tag, bv.messages)
+	}
+	return nil
+}
+
+var slugRe = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ibanLengths - длина IBAN по странам (реестр ISO 13616).
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22,
+	"BH": 22, "BI": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24,
+	"DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18,
+	"FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27,
+	"GT": 28, "HN": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+	"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20,
+	"LU": 20, "LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20,
+	"MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24,
+	"PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24,
+	"SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25,
+	"SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+	"YE": 30,
+}
+
+// validateIBAN - IBAN в электронном формате (без пробелов, заглавными),
+// проверяются длина для страны и контрольная сумма mod 97.
+func validateIBAN(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if len(s) < 4 || ibanLengths[s[:2]] != len(s) {
+		return false
+	}
+
+	var digits strings.Builder
+	for _, c := range s[4:] + s[:4] {
+		switch {
+		case c >= '0' && c <= '9':
+			digits.WriteRune(c)
+		case c >= 'A' && c <= 'Z':
+			digits.WriteString(strconv.Itoa(int(c-'A') + 10))
+		default:
+			return false
+		}
+	}
+
+	n, ok := new(big.Int).SetString(digits.String(), 10)
+	return ok && n.Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func validateSlug(fl validator.FieldLevel) bool {
+	return slugRe.MatchString(fl.Field().String())
+}
+
+// validatePassword: минимум одна строчная, заглавная, цифра и спецсимвол;
+// длина в символах - не меньше параметра (по умолчанию passwordMinLen).
+//
+// Нечисловой параметр - ошибка в теге DTO, а не во вводе: как и встроенные
+// min/max validator, правило паникует при первой проверке такого поля.
+func validatePassword(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+
+	minLen := passwordMinLen
+	if p := fl.Param(); p != "" {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 1 {
+			panic(fmt.Errorf("%w: password=%s", errBadValidatorParam, p))
+		}
+		minLen = n
+	}
+	if utf8.RuneCountInString(s) < minLen {
+		return false
+	}
+
+	var lower, upper, digit, special bool
+	for _, c := range s {
+		switch {
+		case unicode.IsLower(c):
+			lower = true
+		case unicode.IsUpper(c):
+			upper = true
+		case unicode.IsDigit(c):
+			digit = true
+		case unicode.IsPunct(c) || unicode.IsSymbol(c) || c == ' ':
+			special = true
+		}
+	}
+	return lower && upper && digit && special
+}
+
+// reservedFilenames - имена устройств Windows (в любом регистре, с любым расширением).
+var reservedFilenames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true,
+	"COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true,
+	"LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// validateFilename - имя файла, безопасное на Linux, macOS и Windows.
+func validateFilename(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if s == "" || s == "." || s == ".." || len(s) > 255 || !utf8.ValidString(s) {
+		return false
+	}
+	if strings.ContainsAny(s, `/\<>:"|?*`) || strings.HasSuffix(s, ".") || strings.HasSuffix(s, " ") {
+		return false
+	}
+	for _, c := range s {
+		if unicode.IsControl(c) {
+			return false
+		}
+	}
+	base, _, _ := strings.Cut(s, ".")
+	return !reservedFilenames[strings.ToUpper(base)]
+}
+
+func validateNoControl(fl validator.FieldLevel) bool {
+	for _, c := range fl.Field().String() {
+		if unicode.IsControl(c) && c != '\t' && c != '\n' && c != '\r' {
+			return false
+		}
+	}
+	return true
+}
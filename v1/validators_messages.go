@@ -0,0 +1,247 @@
+package httpx
+
+// bundledValidators - каталог RegisterValidators: правило + переводы для всех языков httpx.
+var bundledValidators = map[string]bundledValidator{
+	"tz": {
+		alias: "timezone",
+		messages: map[string]string{
+			"en": "{0} must be a valid IANA time zone (e.g. Europe/Riga)",
+			"ru": "{0} должно быть корректной таймзоной IANA (например, Europe/Moscow)",
+			"de": "{0} muss eine gültige IANA-Zeitzone sein (z. B. Europe/Berlin)",
+			"zh": "{0}必须是有效的IANA时区（例如 Asia/Shanghai）",
+			"fr": "{0} doit être un fuseau horaire IANA valide (par ex. Europe/Paris)",
+			"es": "{0} debe ser una zona horaria IANA válida (p. ej., Europe/Madrid)",
+			"it": "{0} deve essere un fuso orario IANA valido (ad es. Europe/Rome)",
+			"pt": "{0} deve ser um fuso horário IANA válido (ex.: Europe/Lisbon)",
+			"ja": "{0}は有効なIANAタイムゾーンである必要があります（例: Asia/Tokyo）",
+			"ko": "{0}은(는) 유효한 IANA 시간대여야 합니다 (예: Asia/Seoul)",
+			"lv": "{0} jābūt derīgai IANA laika joslai (piemēram, Europe/Riga)",
+			"uk": "{0} має бути коректним часовим поясом IANA (наприклад, Europe/Kyiv)",
+			"pl": "{0} musi być prawidłową strefą czasową IANA (np. Europe/Warsaw)",
+			"tr": "{0} geçerli bir IANA saat dilimi olmalıdır (örn. Europe/Istanbul)",
+			"ar": "يجب أن يكون {0} منطقة زمنية IANA صالحة (مثل Asia/Dubai)",
+			"he": "{0} חייב להיות אזור זמן IANA תקין (לדוגמה Asia/Jerusalem)",
+			"nl": "{0} moet een geldige IANA-tijdzone zijn (bijv. Europe/Amsterdam)",
+		},
+	},
+	"phone": {
+		alias: "e164",
+		messages: map[string]string{
+			"en": "{0} must be a phone number in E.164 format (e.g. +37120000000)",
+			"ru": "{0} должно быть номером телефона в формате E.164 (например, +79990000000)",
+			"de": "{0} muss eine Telefonnummer im E.164-Format sein (z. B. +4915100000000)",
+			"zh": "{0}必须是E.164格式的电话号码（例如 +8613800000000）",
+			"fr": "{0} doit être un numéro de téléphone au format E.164 (par ex. +33600000000)",
+			"es": "{0} debe ser un número de teléfono en formato E.164 (p. ej., +34600000000)",
+			"it": "{0} deve essere un numero di telefono in formato E.164 (ad es. +393400000000)",
+			"pt": "{0} deve ser um número de telefone no formato E.164 (ex.: +351910000000)",
+			"ja": "{0}はE.164形式の電話番号である必要があります（例: +819000000000）",
+			"ko": "{0}은(는) E.164 형식의 전화번호여야 합니다 (예: +821000000000)",
+			"lv": "{0} jābūt tālruņa numuram E.164 formātā (piemēram, +37120000000)",
+			"uk": "{0} має бути номером телефону у форматі E.164 (наприклад, +380500000000)",
+			"pl": "{0} musi być numerem telefonu w formacie E.164 (np. +48500000000)",
+			"tr": "{0} E.164 biçiminde bir telefon numarası olmalıdır (örn. +905000000000)",
+			"ar": "يجب أن يكون {0} رقم هاتف بتنسيق E.164 (مثل +971500000000)",
+			"he": "{0} חייב להיות מספר טלפון בפורמט E.164 (לדוגמה +972500000000)",
+			"nl": "{0} moet een telefoonnummer in E.164-formaat zijn (bijv. +31600000000)",
+		},
+	},
+	"iban": {
+		fn: validateIBAN,
+		messages: map[string]string{
+			"en": "{0} must be a valid IBAN",
+			"ru": "{0} должно быть корректным IBAN",
+			"de": "{0} muss eine gültige IBAN sein",
+			"zh": "{0}必须是有效的IBAN",
+			"fr": "{0} doit être un IBAN valide",
+			"es": "{0} debe ser un IBAN válido",
+			"it": "{0} deve essere un IBAN valido",
+			"pt": "{0} deve ser um IBAN válido",
+			"ja": "{0}は有効なIBANである必要があります",
+			"ko": "{0}은(는) 유효한 IBAN이어야 합니다",
+			"lv": "{0} jābūt derīgam IBAN",
+			"uk": "{0} має бути коректним IBAN",
+			"pl": "{0} musi być prawidłowym numerem IBAN",
+			"tr": "{0} geçerli bir IBAN olmalıdır",
+			"ar": "يجب أن يكون {0} رقم IBAN صالحًا",
+			"he": "{0} חייב להיות IBAN תקין",
+			"nl": "{0} moet een geldig IBAN zijn",
+		},
+	},
+	"swift": {
+		alias: "bic",
+		messages: map[string]string{
+			"en": "{0} must be a valid BIC/SWIFT code",
+			"ru": "{0} должно быть корректным кодом BIC/SWIFT",
+			"de": "{0} muss ein gültiger BIC/SWIFT-Code sein",
+			"zh": "{0}必须是有效的BIC/SWIFT代码",
+			"fr": "{0} doit être un code BIC/SWIFT valide",
+			"es": "{0} debe ser un código BIC/SWIFT válido",
+			"it": "{0} deve essere un codice BIC/SWIFT valido",
+			"pt": "{0} deve ser um código BIC/SWIFT válido",
+			"ja": "{0}は有効なBIC/SWIFTコードである必要があります",
+			"ko": "{0}은(는) 유효한 BIC/SWIFT 코드여야 합니다",
+			"lv": "{0} jābūt derīgam BIC/SWIFT kodam",
+			"uk": "{0} має бути коректним кодом BIC/SWIFT",
+			"pl": "{0} musi być prawidłowym kodem BIC/SWIFT",
+			"tr": "{0} geçerli bir BIC/SWIFT kodu olmalıdır",
+			"ar": "يجب أن يكون {0} رمز BIC/SWIFT صالحًا",
+			"he": "{0} חייב להיות קוד BIC/SWIFT תקין",
+			"nl": "{0} moet een geldige BIC/SWIFT-code zijn",
+		},
+	},
+	"slug": {
+		fn: validateSlug,
+		messages: map[string]string{
+			"en": "{0} may contain only lowercase Latin letters, digits and single hyphens",
+			"ru": "{0} может содержать только строчные латинские буквы, цифры и одиночные дефисы",
+			"de": "{0} darf nur lateinische Kleinbuchstaben, Ziffern und einzelne Bindestriche enthalten",
+			"zh": "{0}只能包含小写拉丁字母、数字和单个连字符",
+			"fr": "{0} ne peut contenir que des lettres latines minuscules, des chiffres et des tirets simples",
+			"es": "{0} solo puede contener letras latinas minúsculas, dígitos y guiones simples",
+			"it": "{0} può contenere solo lettere latine minuscole, cifre e singoli trattini",
+			"pt": "{0} só pode conter letras latinas minúsculas, dígitos e hífens simples",
+			"ja": "{0}には小文字のラテン文字、数字、単一のハイフンのみ使用できます",
+			"ko": "{0}에는 라틴 소문자, 숫자, 단일 하이픈만 사용할 수 있습니다",
+			"lv": "{0} drīkst saturēt tikai mazos latīņu burtus, ciparus un atsevišķas defises",
+			"uk": "{0} може містити лише малі латинські літери, цифри та одиночні дефіси",
+			"pl": "{0} może zawierać tylko małe litery łacińskie, cyfry i pojedyncze myślniki",
+			"tr": "{0} yalnızca küçük Latin harfleri, rakamlar ve tekli kısa çizgiler içerebilir",
+			"ar": "يمكن أن يحتوي {0} على أحرف لاتينية صغيرة وأرقام وشرطات مفردة فقط",
+			"he": "{0} יכול להכיל רק אותיות לטיניות קטנות, ספרות ומקפים בודדים",
+			"nl": "{0} mag alleen kleine Latijnse letters, cijfers en enkele koppeltekens bevatten",
+		},
+	},
+	"version": {
+		alias: "semver",
+		messages: map[string]string{
+			"en": "{0} must be a semantic version (e.g. 1.4.2)",
+			"ru": "{0} должно быть семантической версией (например, 1.4.2)",
+			"de": "{0} muss eine semantische Version sein (z. B. 1.4.2)",
+			"zh": "{0}必须是语义化版本号（例如 1.4.2）",
+			"fr": "{0} doit être une version sémantique (par ex. 1.4.2)",
+			"es": "{0} debe ser una versión semántica (p. ej., 1.4.2)",
+			"it": "{0} deve essere una versione semantica (ad es. 1.4.2)",
+			"pt": "{0} deve ser uma versão semântica (ex.: 1.4.2)",
+			"ja": "{0}はセマンティックバージョンである必要があります（例: 1.4.2）",
+			"ko": "{0}은(는) 시맨틱 버전이어야 합니다 (예: 1.4.2)",
+			"lv": "{0} jābūt semantiskajai versijai (piemēram, 1.4.2)",
+			"uk": "{0} має бути семантичною версією (наприклад, 1.4.2)",
+			"pl": "{0} musi być wersją semantyczną (np. 1.4.2)",
+			"tr": "{0} anlamsal bir sürüm olmalıdır (örn. 1.4.2)",
+			"ar": "يجب أن يكون {0} إصدارًا دلاليًا (مثل 1.4.2)",
+			"he": "{0} חייב להיות גרסה סמנטית (לדוגמה 1.4.2)",
+			"nl": "{0} moet een semantische versie zijn (bijv. 1.4.2)",
+		},
+	},
+	"password": {
+		fn: validatePassword,
+		messages: map[string]string{
+			"en": "{0} must be at least {1} characters long and use upper- and lowercase letters, digits and special characters",
+			"ru": "{0} должен быть не короче {1} символов и содержать строчные и заглавные буквы, цифры и спецсимволы",
+			"de": "{0} muss mindestens {1} Zeichen lang sein und Groß- und Kleinbuchstaben, Ziffern und Sonderzeichen enthalten",
+			"zh": "{0}至少需要{1}个字符，并包含大小写字母、数字和特殊字符",
+			"fr": "{0} doit contenir au moins {1} caractères, dont des majuscules, des minuscules, des chiffres et des caractères spéciaux",
+			"es": "{0} debe tener al menos {1} caracteres e incluir mayúsculas, minúsculas, dígitos y caracteres especiales",
+			"it": "{0} deve contenere almeno {1} caratteri, con lettere maiuscole e minuscole, cifre e caratteri speciali",
+			"pt": "{0} deve ter pelo menos {1} caracteres, com letras maiúsculas e minúsculas, dígitos e caracteres especiais",
+			"ja": "{0}は{1}文字以上で、大文字、小文字、数字、記号を含める必要があります",
+			"ko": "{0}은(는) {1}자 이상이어야 하며 대문자, 소문자, 숫자, 특수 문자를 포함해야 합니다",
+			"lv": "{0} jāsatur vismaz {1} rakstzīmes, tostarp lielie un mazie burti, cipari un speciālās rakstzīmes",
+			"uk": "{0} має містити щонайменше {1} символів, великі й малі літери, цифри та спецсимволи",
+			"pl": "{0} musi mieć co najmniej {1} znaków i zawierać wielkie i małe litery, cyfry oraz znaki specjalne",
+			"tr": "{0} en az {1} karakter olmalı ve büyük ve küçük harfler, rakamlar ve özel karakterler içermelidir",
+			"ar": "يجب أن يتكون {0} من {1} أحرف على الأقل ويحتوي على أحرف كبيرة وصغيرة وأرقام ورموز خاصة",
+			"he": "{0} חייבת להכיל לפחות {1} תווים, כולל אותיות גדולות וקטנות, ספרות ותווים מיוחדים",
+			"nl": "{0} moet minstens {1} tekens bevatten, met hoofdletters, kleine letters, cijfers en speciale tekens",
+		},
+	},
+	"country": {
+		alias: "iso3166_1_alpha2",
+		messages: map[string]string{
+			"en": "{0} must be an ISO 3166-1 alpha-2 country code (e.g. LV)",
+			"ru": "{0} должно быть кодом страны ISO 3166-1 alpha-2 (например, RU)",
+			"de": "{0} muss ein Ländercode nach ISO 3166-1 alpha-2 sein (z. B. DE)",
+			"zh": "{0}必须是ISO 3166-1 alpha-2国家代码（例如 CN）",
+			"fr": "{0} doit être un code pays ISO 3166-1 alpha-2 (par ex. FR)",
+			"es": "{0} debe ser un código de país ISO 3166-1 alfa-2 (p. ej., ES)",
+			"it": "{0} deve essere un codice paese ISO 3166-1 alpha-2 (ad es. IT)",
+			"pt": "{0} deve ser um código de país ISO 3166-1 alfa-2 (ex.: PT)",
+			"ja": "{0}はISO 3166-1 alpha-2の国コードである必要があります（例: JP）",
+			"ko": "{0}은(는) ISO 3166-1 alpha-2 국가 코드여야 합니다 (예: KR)",
+			"lv": "{0} jābūt ISO 3166-1 alpha-2 valsts kodam (piemēram, LV)",
+			"uk": "{0} має бути кодом країни ISO 3166-1 alpha-2 (наприклад, UA)",
+			"pl": "{0} musi być kodem kraju ISO 3166-1 alpha-2 (np. PL)",
+			"tr": "{0} ISO 3166-1 alpha-2 ülke kodu olmalıdır (örn. TR)",
+			"ar": "يجب أن يكون {0} رمز دولة وفق ISO 3166-1 alpha-2 (مثل AE)",
+			"he": "{0} חייב להיות קוד מדינה לפי ISO 3166-1 alpha-2 (לדוגמה IL)",
+			"nl": "{0} moet een landcode volgens ISO 3166-1 alpha-2 zijn (bijv. NL)",
+		},
+	},
+	"currency": {
+		alias: "iso4217",
+		messages: map[string]string{
+			"en": "{0} must be an ISO 4217 currency code (e.g. EUR)",
+			"ru": "{0} должно быть кодом валюты ISO 4217 (например, RUB)",
+			"de": "{0} muss ein Währungscode nach ISO 4217 sein (z. B. EUR)",
+			"zh": "{0}必须是ISO 4217货币代码（例如 CNY）",
+			"fr": "{0} doit être un code de devise ISO 4217 (par ex. EUR)",
+			"es": "{0} debe ser un código de moneda ISO 4217 (p. ej., EUR)",
+			"it": "{0} deve essere un codice valuta ISO 4217 (ad es. EUR)",
+			"pt": "{0} deve ser um código de moeda ISO 4217 (ex.: EUR)",
+			"ja": "{0}はISO 4217の通貨コードである必要があります（例: JPY）",
+			"ko": "{0}은(는) ISO 4217 통화 코드여야 합니다 (예: KRW)",
+			"lv": "{0} jābūt ISO 4217 valūtas kodam (piemēram, EUR)",
+			"uk": "{0} має бути кодом валюти ISO 4217 (наприклад, UAH)",
+			"pl": "{0} musi być kodem waluty ISO 4217 (np. PLN)",
+			"tr": "{0} ISO 4217 para birimi kodu olmalıdır (örn. TRY)",
+			"ar": "يجب أن يكون {0} رمز عملة وفق ISO 4217 (مثل AED)",
+			"he": "{0} חייב להיות קוד מטבע לפי ISO 4217 (לדוגמה ILS)",
+			"nl": "{0} moet een valutacode volgens ISO 4217 zijn (bijv. EUR)",
+		},
+	},
+	"filename": {
+		fn: validateFilename,
+		messages: map[string]string{
+			"en": "{0} must be a safe file name without paths or reserved characters",
+			"ru": "{0} должно быть безопасным именем файла без путей и зарезервированных символов",
+			"de": "{0} muss ein sicherer Dateiname ohne Pfade oder reservierte Zeichen sein",
+			"zh": "{0}必须是不含路径或保留字符的安全文件名",
+			"fr": "{0} doit être un nom de fichier sûr, sans chemin ni caractères réservés",
+			"es": "{0} debe ser un nombre de archivo seguro, sin rutas ni caracteres reservados",
+			"it": "{0} deve essere un nome file sicuro, senza percorsi né caratteri riservati",
+			"pt": "{0} deve ser um nome de arquivo seguro, sem caminhos nem caracteres reservados",
+			"ja": "{0}はパスや予約文字を含まない安全なファイル名である必要があります",
+			"ko": "{0}은(는) 경로나 예약 문자가 없는 안전한 파일 이름이어야 합니다",
+			"lv": "{0} jābūt drošam faila nosaukumam bez ceļiem un rezervētām rakstzīmēm",
+			"uk": "{0} має бути безпечним ім'ям файлу без шляхів і зарезервованих символів",
+			"pl": "{0} musi być bezpieczną nazwą pliku bez ścieżek i znaków zastrzeżonych",
+			"tr": "{0} yol veya ayrılmış karakter içermeyen güvenli bir dosya adı olmalıdır",
+			"ar": "يجب أن يكون {0} اسم ملف آمنًا بدون مسارات أو أحرف محجوزة",
+			"he": "{0} חייב להיות שם קובץ בטוח ללא נתיבים או תווים שמורים",
+			"nl": "{0} moet een veilige bestandsnaam zijn zonder paden of gereserveerde tekens",
+		},
+	},
+	"nocontrol": {
+		fn: validateNoControl,
+		messages: map[string]string{
+			"en": "{0} must not contain control characters",
+			"ru": "{0} не должно содержать управляющих символов",
+			"de": "{0} darf keine Steuerzeichen enthalten",
+			"zh": "{0}不能包含控制字符",
+			"fr": "{0} ne doit pas contenir de caractères de contrôle",
+			"es": "{0} no debe contener caracteres de control",
+			"it": "{0} non deve contenere caratteri di controllo",
+			"pt": "{0} não deve conter caracteres de controle",
+			"ja": "{0}に制御文字を含めることはできません",
+			"ko": "{0}에는 제어 문자를 포함할 수 없습니다",
+			"lv": "{0} nedrīkst saturēt vadības rakstzīmes",
+			"uk": "{0} не має містити керівних символів",
+			"pl": "{0} nie może zawierać znaków sterujących",
+			"tr": "{0} kontrol karakterleri içeremez",
+			"ar": "يجب ألا يحتوي {0} على أحرف تحكم",
+			"he": "{0} אינו יכול להכיל תווי בקרה",
+			"nl": "{0} mag geen stuurtekens bevatten",
+		},
+	},
+}
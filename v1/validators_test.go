@@ -0,0 +1,189 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type bundledDTO struct {
+	TZ       string `json:"tz" validate:"omitempty,tz"`
+	Phone    string `json:"phone" validate:"omitempty,phone"`
+	Swift    string `json:"swift" validate:"omitempty,swift"`
+	Version  string `json:"version" validate:"omitempty,version"`
+	Country  string `json:"country" validate:"omitempty,country"`
+	Currency string `json:"currency" validate:"omitempty,currency"`
+	Password string `json:"password" validate:"omitempty,password"`
+	Secret   string `json:"secret" validate:"omitempty,password=12"`
+}
+
+// bindBundled валидирует dto с английским переводом ошибок.
+func bindBundled(t *testing.T, dto bundledDTO) map[string]string {
+	t.Helper()
+	if err := RegisterValidators(); err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.Header.Set("Accept-Language", "en")
+	details, _ := validate(r, &dto)
+	return details
+}
+
+func TestBundledAliases(t *testing.T) {
+	valid := bundledDTO{
+		TZ:       "Europe/Riga",
+		Phone:    "+37120000000",
+		Swift:    "HABALV22",
+		Version:  "1.4.2-rc.1",
+		Country:  "LV",
+		Currency: "EUR",
+	}
+	if details := bindBundled(t, valid); details != nil {
+		t.Fatalf("valid values rejected: %v", details)
+	}
+
+	invalid := bundledDTO{
+		TZ:       "Local",
+		Phone:    "37120000000",
+		Swift:    "HABA",
+		Version:  "1.4",
+		Country:  "XX",
+		Currency: "EURO",
+	}
+	details := bindBundled(t, invalid)
+	if len(details) != 6 {
+		t.Fatalf("details = %v, want 6 errors", details)
+	}
+	for field, msg := range details {
+		if strings.Contains(msg, "Error:Field validation") {
+			t.Errorf("%s: untranslated message %q", field, msg)
+		}
+	}
+
+	CheckTranslations(t, "tz", "phone", "swift", "version", "country", "currency")
+}
+
+func TestPasswordMessageMinLength(t *testing.T) {
+	details := bindBundled(t, bundledDTO{Password: "short", Secret: "short"})
+	if msg := details["Password"]; !strings.Contains(msg, " 8 ") {
+		t.Errorf("password message = %q, want default minimum 8", msg)
+	}
+	if msg := details["Secret"]; !strings.Contains(msg, " 12 ") {
+		t.Errorf("password=12 message = %q, want minimum 12", msg)
+	}
+}
+
+func TestBundledRules(t *testing.T) {
+	if err := RegisterValidators(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"iban", "LV80BANK0000435195001", true},
+		{"iban", "DE89370400440532013000", true},
+		{"iban", "GB82WEST12345698765432", true},
+		{"iban", "LV81BANK0000435195001", false},   // контрольная сумма
+		{"iban", "LV80BANK000043519500", false},    // длина для LV - 21
+		{"iban", "DE89370400440532013000X", false}, // длина для DE - 22
+		{"iban", "lv80bank0000435195001", false},   // только заглавные
+		{"iban", "LV80 BANK 0000 4351 9500 1", false},
+		{"iban", "XX80BANK0000435195001", false},
+		{"iban", "LV", false},
+
+		{"slug", "hello-world-2", true},
+		{"slug", "a", true},
+		{"slug", "hello--world", false},
+		{"slug", "-hello", false},
+		{"slug", "hello-", false},
+		{"slug", "Hello", false},
+		{"slug", "hello_world", false},
+		{"slug", "", false},
+
+		{"password", "Passw0rd!", true},
+		{"password", "Пароль1!", true}, // длина в символах, не в байтах
+		{"password", "Pass w0rd", true},
+		{"password", "passw0rd!", false}, // нет заглавной
+		{"password", "PASSW0RD!", false}, // нет строчной
+		{"password", "Password!", false}, // нет цифры
+		{"password", "Passw0rd1", false}, // нет спецсимвола
+		{"password", "Pa0!", false},      // короче 8
+		{"password=12", "Passw0rd!", false},
+		{"password=12", "Passw0rd!Pas", true},
+		{"password=4", "Pa0!", true},
+
+		{"filename", "report.pdf", true},
+		{"filename", "Отчёт за май.pdf", true},
+		{"filename", ".env", true},
+		{"filename", "console.txt", true},
+		{"filename", "CON.txt", false},
+		{"filename", "con", false},
+		{"filename", "lpt1.log", false},
+		{"filename", "name.", false},
+		{"filename", "name ", false},
+		{"filename", "a/b.txt", false},
+		{"filename", `a\b.txt`, false},
+		{"filename", "../etc", false},
+		{"filename", "a:b", false},
+		{"filename", "a\x00b", false},
+		{"filename", "a\nb", false},
+		{"filename", "..", false},
+		{"filename", "", false},
+		{"filename", strings.Repeat("a", 256), false},
+		{"filename", "\xff.txt", false},
+
+		{"nocontrol", "line 1\nline\t2\r\n", true},
+		{"nocontrol", "Привет", true},
+		{"nocontrol", "a\x00b", false},
+		{"nocontrol", "\x1b[31mred", false},
+		{"nocontrol", "a\u0085b", false},
+	}
+	for _, tt := range tests {
+		err := V.Var(tt.value, tt.tag)
+		if (err == nil) != tt.valid {
+			t.Errorf("%s(%q): err = %v, want valid = %v", tt.tag, tt.value, err, tt.valid)
+		}
+	}
+
+	CheckTranslations(t, "iban", "slug", "password", "filename", "nocontrol")
+}
+
+func TestPasswordBadParam(t *testing.T) {
+	if err := RegisterValidators("password"); err != nil {
+		t.Fatal(err)
+	}
+	for _, tag := range []string{"password=abc", "password=0", "password=-3"} {
+		func() {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, errBadValidatorParam) {
+					t.Errorf("%s: recovered %v, want errBadValidatorParam panic", tag, err)
+				}
+			}()
+			_ = V.Var("Passw0rd!", tag)
+		}()
+	}
+}
+
+func TestRegisterValidatorsTwice(t *testing.T) {
+	for range 2 {
+		if err := RegisterValidators(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	seen := map[string]bool{}
+	for _, cm := range customMessages {
+		if seen[cm.tag] {
+			t.Errorf("tag %q registered twice", cm.tag)
+		}
+		seen[cm.tag] = true
+	}
+}